@@ -9,8 +9,9 @@ require (
 	github.com/hashicorp/errwrap v1.0.0
 	github.com/hashicorp/terraform v0.14.0
 	github.com/hashicorp/terraform-plugin-sdk v1.17.1
-	github.com/nav-inc/datetime v0.1.3 // indirect
+	github.com/nav-inc/datetime v0.1.3
 	github.com/openlyinc/pointy v1.1.2
 	github.com/outscale/osc-sdk-go/v2 v2.6.0
 	github.com/spf13/cast v1.3.1
+	golang.org/x/crypto v0.0.0-20200820211705-5c72a883971a
 )
@@ -2,8 +2,14 @@ package outscale
 
 import (
 	"crypto/tls"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/logging"
 	oscgo "github.com/outscale/osc-sdk-go/v2"
@@ -19,18 +25,25 @@ type Config struct {
 	Endpoints   map[string]interface{}
 	X509cert    string
 	X509key     string
+	DefaultTags map[string]string
+	MaxRetries  int
+	MaxBackoff  time.Duration
 }
 
 //OutscaleClient client
 type OutscaleClient struct {
-	OSCAPI *oscgo.APIClient
+	OSCAPI      *oscgo.APIClient
+	DefaultTags map[string]string
+	// ClientCertificate tells if the requests are sent with an x509 client certificate.
+	ClientCertificate bool
 }
 
 // Client ...
 func (c *Config) Client() (*OutscaleClient, error) {
 	tlsconfig := &tls.Config{InsecureSkipVerify: false}
 	cert, err := tls.LoadX509KeyPair(c.X509cert, c.X509key)
-	if err == nil {
+	clientCertificate := err == nil
+	if clientCertificate {
 		tlsconfig = &tls.Config{
 			InsecureSkipVerify: false,
 			Certificates:       []tls.Certificate{cert},
@@ -46,7 +59,7 @@ func (c *Config) Client() (*OutscaleClient, error) {
 
 	skipClient.Transport = logging.NewTransport("Outscale", skipClient.Transport)
 
-	skipClient.Transport = NewTransport(c.AccessKeyID, c.SecretKeyID, c.Region, skipClient.Transport)
+	skipClient.Transport = NewTransport(c.AccessKeyID, c.SecretKeyID, c.Region, c.MaxRetries, c.MaxBackoff, skipClient.Transport)
 
 	basePath := fmt.Sprintf("api.%s.outscale.com", c.Region)
 	if endpoint, ok := c.Endpoints["api"]; ok {
@@ -62,8 +75,85 @@ func (c *Config) Client() (*OutscaleClient, error) {
 	oscClient := oscgo.NewAPIClient(oscConfig)
 
 	client := &OutscaleClient{
-		OSCAPI: oscClient,
+		OSCAPI:            oscClient,
+		DefaultTags:       c.DefaultTags,
+		ClientCertificate: clientCertificate,
 	}
 
 	return client, nil
 }
+
+const defaultOSCConfigFile = "~/.osc/config.json"
+
+// oscProfile is a profile of the configuration file shared with the OUTSCALE CLIs.
+type oscProfile struct {
+	AccessKey      string            `json:"access_key"`
+	SecretKey      string            `json:"secret_key"`
+	Region         string            `json:"region"`
+	RegionName     string            `json:"region_name"`
+	Endpoints      map[string]string `json:"endpoints"`
+	X509ClientCert string            `json:"x509_client_cert"`
+	X509ClientKey  string            `json:"x509_client_key"`
+}
+
+// loadOSCProfile reads the profile called name from the configuration file at path.
+func loadOSCProfile(path, name string) (*oscProfile, error) {
+	path, err := expandHomeDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the configuration file %s: %s", path, err)
+	}
+
+	profiles := make(map[string]oscProfile)
+	if err := json.Unmarshal(content, &profiles); err != nil {
+		return nil, fmt.Errorf("unable to parse the configuration file %s: %s", path, err)
+	}
+
+	profile, ok := profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("profile %q not found in the configuration file %s", name, path)
+	}
+	return &profile, nil
+}
+
+// applyOSCProfile fills the settings which are not already set in the config with the ones of the profile.
+func (c *Config) applyOSCProfile(profile *oscProfile) {
+	if c.AccessKeyID == "" {
+		c.AccessKeyID = profile.AccessKey
+	}
+	if c.SecretKeyID == "" {
+		c.SecretKeyID = profile.SecretKey
+	}
+	if c.Region == "" {
+		c.Region = profile.Region
+		if c.Region == "" {
+			c.Region = profile.RegionName
+		}
+	}
+	if endpoint, ok := c.Endpoints["api"]; !ok || endpoint.(string) == "" {
+		if endpoint := profile.Endpoints["api"]; endpoint != "" {
+			c.Endpoints["api"] = endpoint
+		}
+	}
+	if c.X509cert == "" && profile.X509ClientCert != "" {
+		c.X509cert, _ = expandHomeDir(profile.X509ClientCert)
+	}
+	if c.X509key == "" && profile.X509ClientKey != "" {
+		c.X509key, _ = expandHomeDir(profile.X509ClientKey)
+	}
+}
+
+func expandHomeDir(path string) (string, error) {
+	if !strings.HasPrefix(path, "~/") {
+		return path, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, path[2:]), nil
+}
@@ -0,0 +1,92 @@
+package outscale
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const testOSCConfigFile = `{
+	"default": {
+		"access_key": "DEFAULTAK",
+		"secret_key": "DEFAULTSK",
+		"region": "eu-west-2"
+	},
+	"prod": {
+		"access_key": "PRODAK",
+		"secret_key": "PRODSK",
+		"region_name": "cloudgouv-eu-west-1",
+		"endpoints": {
+			"api": "api.cloudgouv-eu-west-1.outscale.com"
+		},
+		"x509_client_cert": "/tmp/cert.pem",
+		"x509_client_key": "/tmp/key.pem"
+	}
+}`
+
+func testWriteOSCConfigFile(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "osc")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	path := filepath.Join(dir, "config.json")
+	if err := ioutil.WriteFile(path, []byte(testOSCConfigFile), 0600); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	return path
+}
+
+func TestLoadProviderProfile(t *testing.T) {
+	path := testWriteOSCConfigFile(t)
+	defer os.RemoveAll(filepath.Dir(path))
+
+	config := Config{
+		AccessKeyID: "EXPLICITAK",
+		Endpoints:   make(map[string]interface{}),
+	}
+	if err := loadProviderProfile(&config, "prod", path); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := Config{
+		AccessKeyID: "EXPLICITAK",
+		SecretKeyID: "PRODSK",
+		Region:      "cloudgouv-eu-west-1",
+		X509cert:    "/tmp/cert.pem",
+		X509key:     "/tmp/key.pem",
+	}
+	if config.AccessKeyID != expected.AccessKeyID || config.SecretKeyID != expected.SecretKeyID ||
+		config.Region != expected.Region || config.X509cert != expected.X509cert || config.X509key != expected.X509key {
+		t.Fatalf("expected %+v, got %+v", expected, config)
+	}
+	if config.Endpoints["api"] != "api.cloudgouv-eu-west-1.outscale.com" {
+		t.Fatalf("expected the api endpoint of the profile, got %v", config.Endpoints["api"])
+	}
+}
+
+func TestLoadProviderProfile_defaultProfile(t *testing.T) {
+	path := testWriteOSCConfigFile(t)
+	defer os.RemoveAll(filepath.Dir(path))
+
+	config := Config{Endpoints: make(map[string]interface{})}
+	if err := loadProviderProfile(&config, "", path); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if config.AccessKeyID != "DEFAULTAK" || config.Region != "eu-west-2" {
+		t.Fatalf("expected the default profile, got %+v", config)
+	}
+}
+
+func TestLoadProviderProfile_errors(t *testing.T) {
+	path := testWriteOSCConfigFile(t)
+	defer os.RemoveAll(filepath.Dir(path))
+
+	config := Config{Endpoints: make(map[string]interface{})}
+	if err := loadProviderProfile(&config, "unknown", path); err == nil {
+		t.Fatal("expected an error for an unknown profile")
+	}
+	if err := loadProviderProfile(&config, "default", path+".missing"); err == nil {
+		t.Fatal("expected an error for a missing configuration file")
+	}
+}
@@ -0,0 +1,162 @@
+package outscale
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// filterValueType is the format expected for the values of a data source filter.
+type filterValueType int
+
+const (
+	filterString filterValueType = iota
+	filterBool
+	filterInt
+	filterFloat
+	filterDate
+)
+
+func (t filterValueType) String() string {
+	switch t {
+	case filterBool:
+		return "boolean"
+	case filterInt:
+		return "integer"
+	case filterFloat:
+		return "number"
+	case filterDate:
+		return "date"
+	}
+	return "string"
+}
+
+// dataSourceFilters maps the filter names supported by a data source to the format of their values.
+type dataSourceFilters map[string]filterValueType
+
+func (f dataSourceFilters) names() []string {
+	names := make([]string, 0, len(f))
+	for name := range f {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// dataSourceFiltersSchemaFor returns the filter schema of a data source which rejects the
+// filter names it doesn't support when the configuration is validated.
+func dataSourceFiltersSchemaFor(supported dataSourceFilters) *schema.Schema {
+	filterSchema := dataSourceFiltersSchema()
+	filterSchema.Elem.(*schema.Resource).Schema["name"].ValidateFunc = validateDataSourceFilterName(supported)
+	return filterSchema
+}
+
+func validateDataSourceFilterName(supported dataSourceFilters) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		if err := checkDataSourceFilterName(v.(string), supported); err != nil {
+			errors = append(errors, fmt.Errorf("%s: %s", k, err))
+		}
+		return
+	}
+}
+
+func checkDataSourceFilterName(name string, supported dataSourceFilters) error {
+	if _, ok := supported[name]; ok {
+		return nil
+	}
+	if len(supported) == 0 {
+		return fmt.Errorf("unsupported filter name %q, this data source doesn't support filters", name)
+	}
+	if suggestion := suggestDataSourceFilterName(name, supported); suggestion != "" {
+		return fmt.Errorf("unsupported filter name %q, did you mean %q?", name, suggestion)
+	}
+	return fmt.Errorf("unsupported filter name %q, expected one of: %s", name, strings.Join(supported.names(), ", "))
+}
+
+// validateDataSourceFilters checks the names and the format of the values of the filters
+// before they are sent to the API.
+func validateDataSourceFilters(set *schema.Set, supported dataSourceFilters) error {
+	for _, v := range set.List() {
+		m := v.(map[string]interface{})
+		name := m["name"].(string)
+		if err := checkDataSourceFilterName(name, supported); err != nil {
+			return err
+		}
+
+		values := m["values"].([]interface{})
+		valueType := supported[name]
+		if valueType == filterBool && len(values) != 1 {
+			return fmt.Errorf("filter %q expects a single boolean value, got %d values", name, len(values))
+		}
+		for _, e := range values {
+			value, _ := e.(string)
+			if !isValidFilterValue(value, valueType) {
+				return fmt.Errorf("filter %q expects %s values, got %q", name, valueType, value)
+			}
+		}
+	}
+	return nil
+}
+
+func isValidFilterValue(value string, valueType filterValueType) bool {
+	var err error
+	switch valueType {
+	case filterBool:
+		_, err = strconv.ParseBool(value)
+	case filterInt:
+		_, err = strconv.ParseInt(value, 10, 32)
+	case filterFloat:
+		_, err = strconv.ParseFloat(value, 32)
+	case filterDate:
+		if _, err = time.Parse("2006-01-02", value); err != nil {
+			_, err = time.Parse(time.RFC3339, value)
+		}
+	}
+	return err == nil
+}
+
+// suggestDataSourceFilterName returns the supported filter name closest to name, or an
+// empty string when none of them is close enough to be a typo.
+func suggestDataSourceFilterName(name string, supported dataSourceFilters) string {
+	suggestion, best := "", len(name)/3+1
+	for _, candidate := range supported.names() {
+		if distance := levenshteinDistance(name, candidate); distance <= best {
+			suggestion, best = candidate, distance-1
+		}
+	}
+	return suggestion
+}
+
+func levenshteinDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min3(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
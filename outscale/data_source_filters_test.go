@@ -0,0 +1,257 @@
+package outscale
+
+import (
+	"strings"
+	"testing"
+
+	oscgo "github.com/outscale/osc-sdk-go/v2"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func testDataSourceFiltersSet(filters map[string][]interface{}) *schema.Set {
+	set := schema.NewSet(schema.HashResource(dataSourceFiltersSchema().Elem.(*schema.Resource)), nil)
+	for name, values := range filters {
+		set.Add(map[string]interface{}{"name": name, "values": values})
+	}
+	return set
+}
+
+func TestValidateDataSourceFilterName(t *testing.T) {
+	validate := validateDataSourceFilterName(volumeFilters)
+
+	if _, errs := validate("volume_ids", "filter.0.name"); len(errs) != 0 {
+		t.Fatalf("expected volume_ids to be valid, got %v", errs)
+	}
+
+	_, errs := validate("volume_id", "filter.0.name")
+	if len(errs) != 1 || !strings.Contains(errs[0].Error(), `did you mean "volume_ids"?`) {
+		t.Fatalf("expected a suggestion for volume_id, got %v", errs)
+	}
+
+	_, errs = validate("foo", "filter.0.name")
+	if len(errs) != 1 || !strings.Contains(errs[0].Error(), "expected one of: creation_dates,") {
+		t.Fatalf("expected the list of supported names for foo, got %v", errs)
+	}
+}
+
+func TestValidateDataSourceFilters(t *testing.T) {
+	cases := []struct {
+		filters map[string][]interface{}
+		err     string
+	}{
+		{
+			filters: map[string][]interface{}{
+				"volume_sizes":                      {"10", "20"},
+				"link_volume_delete_on_vm_deletion": {"true"},
+				"creation_dates":                    {"2021-01-01", "2021-02-01T10:00:00Z"},
+			},
+		},
+		{
+			filters: map[string][]interface{}{"volume_sizes": {"ten"}},
+			err:     `filter "volume_sizes" expects integer values, got "ten"`,
+		},
+		{
+			filters: map[string][]interface{}{"link_volume_delete_on_vm_deletion": {"yes"}},
+			err:     `filter "link_volume_delete_on_vm_deletion" expects boolean values, got "yes"`,
+		},
+		{
+			filters: map[string][]interface{}{"link_volume_delete_on_vm_deletion": {"true", "false"}},
+			err:     "expects a single boolean value",
+		},
+		{
+			filters: map[string][]interface{}{"creation_dates": {"01/02/2021"}},
+			err:     `filter "creation_dates" expects date values, got "01/02/2021"`,
+		},
+		{
+			filters: map[string][]interface{}{"volume_type": {"gp2"}},
+			err:     `did you mean "volume_types"?`,
+		},
+	}
+
+	for i, tc := range cases {
+		err := validateDataSourceFilters(testDataSourceFiltersSet(tc.filters), volumeFilters)
+		if tc.err == "" {
+			if err != nil {
+				t.Fatalf("case %d: unexpected error: %s", i, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tc.err) {
+			t.Fatalf("case %d: expected error containing %q, got %v", i, tc.err, err)
+		}
+	}
+}
+
+// TestDataSourceFiltersBuilders checks that the builders handle every filter name accepted
+// by the validation, and reject the other ones.
+func TestDataSourceFiltersBuilders(t *testing.T) {
+	validValues := map[filterValueType]string{
+		filterString: "value",
+		filterBool:   "true",
+		filterInt:    "1",
+		filterFloat:  "1.5",
+		filterDate:   "2021-01-01",
+	}
+
+	cases := []struct {
+		supported dataSourceFilters
+		build     func(*schema.Set) error
+	}{
+		{accessKeyFilters, func(s *schema.Set) error {
+			_, err := buildOutscaleDataSourceAccessKeyFilters(s)
+			return err
+		}},
+		{apiAccessRuleFilters, func(s *schema.Set) error {
+			_, err := buildOutscaleDataSourceApiAccessRuleFilters(s)
+			return err
+		}},
+		{caFilters, func(s *schema.Set) error {
+			_, err := buildOutscaleDataSourceCaFilters(s)
+			return err
+		}},
+		{clientGatewayFilters, func(s *schema.Set) error {
+			_, err := buildOutscaleDataSourceClientGatewayFilters(s)
+			return err
+		}},
+		{dhcpOptionFilters, func(s *schema.Set) error {
+			_, err := buildOutscaleDataSourceDHCPOptionFilters(s)
+			return err
+		}},
+		{directLinkFilters, func(s *schema.Set) error {
+			_, err := buildOutscaleDataSourceDirectLinkFilters(s)
+			return err
+		}},
+		{flexibleGpuFilters, func(s *schema.Set) error {
+			_, err := buildOutscaleOAPIDataSourceFlexibleGpuFilters(s)
+			return err
+		}},
+		{imageExportTaskFilters, func(s *schema.Set) error {
+			_, err := buildOutscaleOSCAPIDataSourceImageExportTaskFilters(s)
+			return err
+		}},
+		{imageFilters, func(s *schema.Set) error {
+			_, err := buildOutscaleOAPIDataSourceImagesFilters(s)
+			return err
+		}},
+		{internetServiceFilters, func(s *schema.Set) error {
+			_, err := buildOutscaleOSCAPIDataSourceInternetServiceFilters(s)
+			return err
+		}},
+		{keypairFilters, func(s *schema.Set) error {
+			_, err := buildOutscaleOAPIKeyPairsDataSourceFilters(s)
+			return err
+		}},
+		{lbFilters, func(s *schema.Set) error {
+			_, err := buildOutscaleDataSourceLBFilters(s)
+			return err
+		}},
+		{listenerRuleFilters, func(s *schema.Set) error {
+			_, err := buildOutscaleDataSourceListenerRuleFilters(s)
+			return err
+		}},
+		{natServiceFilters, func(s *schema.Set) error {
+			_, err := buildOutscaleOAPINatServiceDataSourceFilters(s)
+			return err
+		}},
+		{netFilters, func(s *schema.Set) error {
+			_, err := buildOutscaleOAPIDataSourceNetFilters(s)
+			return err
+		}},
+		{napFilters, func(s *schema.Set) error {
+			_, err := buildOutscaleDataSourcesNAPFilters(s)
+			return err
+		}},
+		{netPeeringFilters, func(s *schema.Set) error {
+			_, err := buildOutscaleOAPILinPeeringConnectionFilters(s)
+			return err
+		}},
+		{nicFilters, func(s *schema.Set) error {
+			_, err := buildOutscaleOAPIDataSourceNicFilters(s)
+			return err
+		}},
+		{productTypeFilters, func(s *schema.Set) error {
+			_, err := buildOutscaleOAPIProductTypeDataSourceFilters(s)
+			return err
+		}},
+		{publicIPFilters, func(s *schema.Set) error {
+			_, err := buildOutscaleOAPIDataSourcePublicIpsFilters(s)
+			return err
+		}},
+		{quotaFilters, func(s *schema.Set) error {
+			_, err := buildOutscaleOAPIQuotaDataSourceFilters(s)
+			return err
+		}},
+		{routeTableFilters, func(s *schema.Set) error {
+			_, err := buildOutscaleOAPIDataSourceRouteTableFilters(s)
+			return err
+		}},
+		{securityGroupFilters, func(s *schema.Set) error {
+			_, err := buildOutscaleOAPIDataSourceSecurityGroupFilters(s)
+			return err
+		}},
+		{serverCertificateFilters, func(s *schema.Set) error {
+			_, err := buildOutscaleOSCAPIDataSourceServerCertificateFilters(s)
+			return err
+		}},
+		{snapshotExportTaskFilters, func(s *schema.Set) error {
+			_, err := buildOutscaleOSCAPIDataSourceSnapshotExportTaskFilters(s)
+			return err
+		}},
+		{subnetFilters, func(s *schema.Set) error {
+			_, err := buildOutscaleOAPISubnetDataSourceFilters(s)
+			return err
+		}},
+		{subregionFilters, func(s *schema.Set) error {
+			_, err := buildOutscaleOAPIDataSourceSubregionsFilters(s)
+			return err
+		}},
+		{tagFilters, func(s *schema.Set) error {
+			_, err := oapiBuildOutscaleDataSourceFilters(s)
+			return err
+		}},
+		{virtualGatewayFilters, func(s *schema.Set) error {
+			_, err := buildOutscaleAPIVirtualGatewayFilters(s)
+			return err
+		}},
+		{vmStateFilters, func(s *schema.Set) error {
+			_, err := buildOutscaleOAPIDataSourceVMStateFilters(s)
+			return err
+		}},
+		{vmTypeFilters, func(s *schema.Set) error {
+			_, err := buildOutscaleOAPIDataSourceVMTypesFilters(s)
+			return err
+		}},
+		{volumeFilters, func(s *schema.Set) error {
+			_, err := buildOutscaleOSCAPIDataSourceVolumesFilters(s)
+			return err
+		}},
+		{vpnConnectionFilters, func(s *schema.Set) error {
+			_, err := buildOutscaleDataSourceVPNConnectionFilters(s)
+			return err
+		}},
+		{snapshotFilters, func(s *schema.Set) error {
+			_, err := buildOutscaleOapiSnapshootDataSourceFilters(s, &oscgo.FiltersSnapshot{})
+			return err
+		}},
+	}
+
+	for _, c := range cases {
+		for _, name := range c.supported.names() {
+			set := testDataSourceFiltersSet(map[string][]interface{}{name: {validValues[c.supported[name]]}})
+			if err := c.build(set); err != nil {
+				t.Errorf("filter %q: %s", name, err)
+			}
+		}
+		if err := c.build(testDataSourceFiltersSet(map[string][]interface{}{"unknown": {"value"}})); err == nil {
+			t.Errorf("expected an error for an unknown filter name with %v", c.supported.names())
+		}
+	}
+}
+
+func TestCheckDataSourceFilterName_noFilters(t *testing.T) {
+	err := checkDataSourceFilterName("model_names", nil)
+	if err == nil || !strings.Contains(err.Error(), "doesn't support filters") {
+		t.Fatalf("expected filters to be rejected, got %v", err)
+	}
+}
@@ -3,7 +3,6 @@ package outscale
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 	"time"
 
@@ -18,7 +17,7 @@ func dataSourceOutscaleAccessKey() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceOutscaleAccessKeyRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter": dataSourceFiltersSchemaFor(accessKeyFilters),
 			"access_key_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -52,6 +51,9 @@ func dataSourceOutscaleAccessKeyRead(d *schema.ResourceData, meta interface{}) e
 	conn := meta.(*OutscaleClient).OSCAPI
 
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), accessKeyFilters); err != nil {
+		return err
+	}
 	accessKeyID, accessKeyOk := d.GetOk("access_key_id")
 	state, stateOk := d.GetOk("state")
 
@@ -61,7 +63,11 @@ func dataSourceOutscaleAccessKeyRead(d *schema.ResourceData, meta interface{}) e
 
 	filterReq := &oscgo.FiltersAccessKeys{}
 	if filtersOk {
-		filterReq = buildOutscaleDataSourceAccessKeyFilters(filters.(*schema.Set))
+		var err error
+		filterReq, err = buildOutscaleDataSourceAccessKeyFilters(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
 	}
 	if accessKeyOk {
 		filterReq.SetAccessKeyIds([]string{accessKeyID.(string)})
@@ -117,7 +123,13 @@ func dataSourceOutscaleAccessKeyRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func buildOutscaleDataSourceAccessKeyFilters(set *schema.Set) *oscgo.FiltersAccessKeys {
+// accessKeyFilters are the filters supported by the access key data sources.
+var accessKeyFilters = dataSourceFilters{
+	"access_key_ids": filterString,
+	"states":         filterString,
+}
+
+func buildOutscaleDataSourceAccessKeyFilters(set *schema.Set) (*oscgo.FiltersAccessKeys, error) {
 	var filters oscgo.FiltersAccessKeys
 	for _, v := range set.List() {
 		m := v.(map[string]interface{})
@@ -132,8 +144,8 @@ func buildOutscaleDataSourceAccessKeyFilters(set *schema.Set) *oscgo.FiltersAcce
 		case "states":
 			filters.SetStates(filterValues)
 		default:
-			log.Printf("[Debug] Unknown Filter Name: %s.", name)
+			return nil, fmt.Errorf("unknown filter name %q", name)
 		}
 	}
-	return &filters
+	return &filters, nil
 }
@@ -17,7 +17,7 @@ func dataSourceOutscaleAccessKeys() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceOutscaleAccessKeysRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter": dataSourceFiltersSchemaFor(accessKeyFilters),
 			"access_key_ids": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -71,6 +71,9 @@ func dataSourceOutscaleAccessKeysRead(d *schema.ResourceData, meta interface{})
 	conn := meta.(*OutscaleClient).OSCAPI
 
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), accessKeyFilters); err != nil {
+		return err
+	}
 	accessKeyID, accessKeyOk := d.GetOk("access_key_ids")
 	state, stateOk := d.GetOk("states")
 
@@ -80,7 +83,11 @@ func dataSourceOutscaleAccessKeysRead(d *schema.ResourceData, meta interface{})
 
 	filterReq := &oscgo.FiltersAccessKeys{}
 	if filtersOk {
-		filterReq = buildOutscaleDataSourceAccessKeyFilters(filters.(*schema.Set))
+		var err error
+		filterReq, err = buildOutscaleDataSourceAccessKeyFilters(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
 	}
 	if accessKeyOk {
 		filterReq.SetAccessKeyIds(expandStringValueList(accessKeyID.([]interface{})))
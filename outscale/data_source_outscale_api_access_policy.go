@@ -0,0 +1,47 @@
+package outscale
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceOutscaleApiAccessPolicy() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceOutscaleApiAccessPolicyRead,
+		Schema: map[string]*schema.Schema{
+			"max_access_key_expiration_seconds": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"require_trusted_env": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"request_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceOutscaleApiAccessPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*OutscaleClient).OSCAPI
+
+	policy, requestID, err := readOutscaleApiAccessPolicy(conn)
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("max_access_key_expiration_seconds", policy.GetMaxAccessKeyExpirationSeconds()); err != nil {
+		return err
+	}
+	if err := d.Set("require_trusted_env", policy.GetRequireTrustedEnv()); err != nil {
+		return err
+	}
+	if err := d.Set("request_id", requestID); err != nil {
+		return err
+	}
+
+	d.SetId(apiAccessPolicyID)
+	return nil
+}
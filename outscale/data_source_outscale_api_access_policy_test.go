@@ -0,0 +1,27 @@
+package outscale
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccOutscaleApiAccessPolicyDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOutscaleApiAccessPolicyDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.outscale_api_access_policy.policy", "max_access_key_expiration_seconds"),
+					resource.TestCheckResourceAttrSet("data.outscale_api_access_policy.policy", "require_trusted_env"),
+				),
+			},
+		},
+	})
+}
+
+const testAccOutscaleApiAccessPolicyDataSourceConfig = `
+	data "outscale_api_access_policy" "policy" {}
+`
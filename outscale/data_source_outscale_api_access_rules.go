@@ -0,0 +1,134 @@
+package outscale
+
+import (
+	"fmt"
+
+	oscgo "github.com/outscale/osc-sdk-go/v2"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceOutscaleApiAccessRules() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceOutscaleApiAccessRulesRead,
+		Schema: map[string]*schema.Schema{
+			"filter": dataSourceFiltersSchemaFor(apiAccessRuleFilters),
+			"api_access_rules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"api_access_rule_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ca_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"cns": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip_ranges": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"request_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceOutscaleApiAccessRulesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*OutscaleClient).OSCAPI
+
+	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), apiAccessRuleFilters); err != nil {
+		return err
+	}
+
+	var filtersReq *oscgo.FiltersApiAccessRule
+	if filtersOk {
+		var err error
+		filtersReq, err = buildOutscaleDataSourceApiAccessRuleFilters(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
+	}
+
+	rules, requestID, err := readOutscaleApiAccessRules(conn, filtersReq)
+	if err != nil {
+		return fmt.Errorf("error reading Outscale API access rules: %s", err)
+	}
+
+	apiAccessRules := make([]map[string]interface{}, len(rules))
+	for i, rule := range rules {
+		apiAccessRules[i] = map[string]interface{}{
+			"api_access_rule_id": rule.GetApiAccessRuleId(),
+			"ca_ids":             rule.GetCaIds(),
+			"cns":                rule.GetCns(),
+			"description":        rule.GetDescription(),
+			"ip_ranges":          rule.GetIpRanges(),
+		}
+	}
+
+	if err := d.Set("api_access_rules", apiAccessRules); err != nil {
+		return err
+	}
+	if err := d.Set("request_id", requestID); err != nil {
+		return err
+	}
+
+	d.SetId(resource.UniqueId())
+	return nil
+}
+
+// apiAccessRuleFilters are the filters supported by the API access rule data sources.
+var apiAccessRuleFilters = dataSourceFilters{
+	"api_access_rule_ids": filterString,
+	"ca_ids":              filterString,
+	"cns":                 filterString,
+	"descriptions":        filterString,
+	"ip_ranges":           filterString,
+}
+
+func buildOutscaleDataSourceApiAccessRuleFilters(set *schema.Set) (*oscgo.FiltersApiAccessRule, error) {
+	var filters oscgo.FiltersApiAccessRule
+	for _, v := range set.List() {
+		m := v.(map[string]interface{})
+		var filterValues []string
+		for _, e := range m["values"].([]interface{}) {
+			filterValues = append(filterValues, e.(string))
+		}
+
+		switch name := m["name"].(string); name {
+		case "api_access_rule_ids":
+			filters.SetApiAccessRuleIds(filterValues)
+		case "ca_ids":
+			filters.SetCaIds(filterValues)
+		case "cns":
+			filters.SetCns(filterValues)
+		case "descriptions":
+			filters.SetDescriptions(filterValues)
+		case "ip_ranges":
+			filters.SetIpRanges(filterValues)
+		default:
+			return nil, fmt.Errorf("unknown filter name %q", name)
+		}
+	}
+	return &filters, nil
+}
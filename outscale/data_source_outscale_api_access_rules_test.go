@@ -0,0 +1,26 @@
+package outscale
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccOutscaleApiAccessRulesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOutscaleApiAccessRulesDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.outscale_api_access_rules.rules", "api_access_rules.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccOutscaleApiAccessRulesDataSourceConfig = `
+	data "outscale_api_access_rules" "rules" {}
+`
@@ -0,0 +1,130 @@
+package outscale
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	oscgo "github.com/outscale/osc-sdk-go/v2"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-outscale/utils"
+)
+
+func dataSourceOutscaleCas() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceOutscaleCasRead,
+		Schema: map[string]*schema.Schema{
+			"filter": dataSourceFiltersSchemaFor(caFilters),
+			"cas": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ca_fingerprint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ca_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"request_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceOutscaleCasRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*OutscaleClient).OSCAPI
+
+	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), caFilters); err != nil {
+		return err
+	}
+
+	req := oscgo.ReadCasRequest{}
+	if filtersOk {
+		filtersReq, err := buildOutscaleDataSourceCaFilters(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
+		req.SetFilters(filtersReq)
+	}
+
+	var resp oscgo.ReadCasResponse
+	var err error
+	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		resp, _, err = conn.CaApi.ReadCas(context.Background()).ReadCasRequest(req).Execute()
+		if err != nil {
+			if strings.Contains(fmt.Sprint(err), "RequestLimitExceeded:") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error reading Outscale CAs: %s", utils.GetErrorResponse(err))
+	}
+
+	cas := make([]map[string]interface{}, len(resp.GetCas()))
+	for i, ca := range resp.GetCas() {
+		cas[i] = map[string]interface{}{
+			"ca_fingerprint": ca.GetCaFingerprint(),
+			"ca_id":          ca.GetCaId(),
+			"description":    ca.GetDescription(),
+		}
+	}
+
+	if err := d.Set("cas", cas); err != nil {
+		return err
+	}
+	if err := d.Set("request_id", resp.ResponseContext.GetRequestId()); err != nil {
+		return err
+	}
+
+	d.SetId(resource.UniqueId())
+	return nil
+}
+
+// caFilters are the filters supported by the CA data sources.
+var caFilters = dataSourceFilters{
+	"ca_fingerprints": filterString,
+	"ca_ids":          filterString,
+	"descriptions":    filterString,
+}
+
+func buildOutscaleDataSourceCaFilters(set *schema.Set) (oscgo.FiltersCa, error) {
+	var filters oscgo.FiltersCa
+	for _, v := range set.List() {
+		m := v.(map[string]interface{})
+		var filterValues []string
+		for _, e := range m["values"].([]interface{}) {
+			filterValues = append(filterValues, e.(string))
+		}
+
+		switch name := m["name"].(string); name {
+		case "ca_fingerprints":
+			filters.SetCaFingerprints(filterValues)
+		case "ca_ids":
+			filters.SetCaIds(filterValues)
+		case "descriptions":
+			filters.SetDescriptions(filterValues)
+		default:
+			return oscgo.FiltersCa{}, fmt.Errorf("unknown filter name %q", name)
+		}
+	}
+	return filters, nil
+}
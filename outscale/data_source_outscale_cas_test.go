@@ -0,0 +1,43 @@
+package outscale
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccOutscaleCasDataSource_basic(t *testing.T) {
+	caPem := testAccOutscaleCaPem(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOutscaleCasDataSourceConfig(caPem),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.outscale_cas.cas", "cas.#", "1"),
+					resource.TestCheckResourceAttr("data.outscale_cas.cas", "cas.0.description", "terraform ca data source"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOutscaleCasDataSourceConfig(caPem string) string {
+	return fmt.Sprintf(`
+		resource "outscale_ca" "ca" {
+			ca_pem      = <<EOT
+%sEOT
+			description = "terraform ca data source"
+		}
+
+		data "outscale_cas" "cas" {
+			filter {
+				name   = "ca_ids"
+				values = ["${outscale_ca.ca.id}"]
+			}
+		}
+	`, caPem)
+}
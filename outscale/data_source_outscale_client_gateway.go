@@ -18,7 +18,7 @@ func dataSourceOutscaleClientGateway() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceOutscaleClientGatewayRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter": dataSourceFiltersSchemaFor(clientGatewayFilters),
 			"bgp_asn": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -52,6 +52,9 @@ func dataSourceOutscaleClientGatewayRead(d *schema.ResourceData, meta interface{
 	conn := meta.(*OutscaleClient).OSCAPI
 
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), clientGatewayFilters); err != nil {
+		return err
+	}
 	clientGatewayID, clientGatewayOk := d.GetOk("client_gateway_id")
 
 	if !filtersOk && !clientGatewayOk {
@@ -67,7 +70,11 @@ func dataSourceOutscaleClientGatewayRead(d *schema.ResourceData, meta interface{
 	}
 
 	if filtersOk {
-		params.Filters = buildOutscaleDataSourceClientGatewayFilters(filters.(*schema.Set))
+		var err error
+		params.Filters, err = buildOutscaleDataSourceClientGatewayFilters(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
 	}
 
 	var resp oscgo.ReadClientGatewaysResponse
@@ -120,7 +127,19 @@ func dataSourceOutscaleClientGatewayRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func buildOutscaleDataSourceClientGatewayFilters(set *schema.Set) *oscgo.FiltersClientGateway {
+// clientGatewayFilters are the filters supported by the client gateway data sources.
+var clientGatewayFilters = dataSourceFilters{
+	"bgp_asns":           filterInt,
+	"client_gateway_ids": filterString,
+	"connection_types":   filterString,
+	"public_ips":         filterString,
+	"states":             filterString,
+	"tag_keys":           filterString,
+	"tag_values":         filterString,
+	"tags":               filterString,
+}
+
+func buildOutscaleDataSourceClientGatewayFilters(set *schema.Set) (*oscgo.FiltersClientGateway, error) {
 	var filters oscgo.FiltersClientGateway
 	for _, v := range set.List() {
 		log.Printf("[DEBUG] gateway filters %+v", v)
@@ -148,8 +167,8 @@ func buildOutscaleDataSourceClientGatewayFilters(set *schema.Set) *oscgo.Filters
 		case "tags":
 			filters.SetTags(filterValues)
 		default:
-			log.Printf("[Debug] Unknown Filter Name: %s.", name)
+			return nil, fmt.Errorf("unknown filter name %q", name)
 		}
 	}
-	return &filters
+	return &filters, nil
 }
@@ -16,7 +16,7 @@ func dataSourceOutscaleClientGateways() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceOutscaleClientGatewaysRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter": dataSourceFiltersSchemaFor(clientGatewayFilters),
 			"client_gateway_ids": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -65,6 +65,9 @@ func dataSourceOutscaleClientGatewaysRead(d *schema.ResourceData, meta interface
 	conn := meta.(*OutscaleClient).OSCAPI
 
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), clientGatewayFilters); err != nil {
+		return err
+	}
 	clientGatewayIDs, clientGatewayOk := d.GetOk("client_gateway_ids")
 
 	if !filtersOk && !clientGatewayOk {
@@ -80,7 +83,11 @@ func dataSourceOutscaleClientGatewaysRead(d *schema.ResourceData, meta interface
 	}
 
 	if filtersOk {
-		params.Filters = buildOutscaleDataSourceClientGatewayFilters(filters.(*schema.Set))
+		var err error
+		params.Filters, err = buildOutscaleDataSourceClientGatewayFilters(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
 	}
 
 	var resp oscgo.ReadClientGatewaysResponse
@@ -3,7 +3,6 @@ package outscale
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 	"time"
 
@@ -19,7 +18,7 @@ func dataSourceOutscaleDHCPOption() *schema.Resource {
 		Read: dataSourceOutscaleDHCPOptionRead,
 
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter": dataSourceFiltersSchemaFor(dhcpOptionFilters),
 			"dhcp_options_set_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -59,6 +58,9 @@ func dataSourceOutscaleDHCPOptionRead(d *schema.ResourceData, meta interface{})
 	conn := meta.(*OutscaleClient).OSCAPI
 
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), dhcpOptionFilters); err != nil {
+		return err
+	}
 	dhcpID, dhcpIDOk := d.GetOk("dhcp_options_set_id")
 	if !dhcpIDOk && !filtersOk {
 		return fmt.Errorf("One of filters, or dhcp_options_set_id must be provided")
@@ -71,7 +73,11 @@ func dataSourceOutscaleDHCPOptionRead(d *schema.ResourceData, meta interface{})
 		}
 	}
 	if filtersOk {
-		params.Filters = buildOutscaleDataSourceDHCPOptionFilters(filters.(*schema.Set))
+		var err error
+		params.Filters, err = buildOutscaleDataSourceDHCPOptionFilters(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
 	}
 
 	var resp oscgo.ReadDhcpOptionsResponse
@@ -124,7 +130,23 @@ func dataSourceOutscaleDHCPOptionRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func buildOutscaleDataSourceDHCPOptionFilters(set *schema.Set) *oscgo.FiltersDhcpOptions {
+// dhcpOptionFilters are the filters supported by the DHCP option data sources.
+var dhcpOptionFilters = dataSourceFilters{
+	"dhcp_options_set_ids": filterString,
+	"dhcp_options_set_id":  filterString,
+	"domain_name_servers":  filterString,
+	"domain_name_server":   filterString,
+	"domain_names":         filterString,
+	"domain_name":          filterString,
+	"ntp_servers":          filterString,
+	"ntp_server":           filterString,
+	"tag_keys":             filterString,
+	"tag_values":           filterString,
+	"tags":                 filterString,
+	"default":              filterBool,
+}
+
+func buildOutscaleDataSourceDHCPOptionFilters(set *schema.Set) (*oscgo.FiltersDhcpOptions, error) {
 	var filters oscgo.FiltersDhcpOptions
 	for _, v := range set.List() {
 		m := v.(map[string]interface{})
@@ -159,8 +181,8 @@ func buildOutscaleDataSourceDHCPOptionFilters(set *schema.Set) *oscgo.FiltersDhc
 		case "default":
 			filters.SetDefault(cast.ToBool(filterValues[0]))
 		default:
-			log.Printf("[Debug] Unknown Filter Name: %s.", name)
+			return nil, fmt.Errorf("unknown filter name %q", name)
 		}
 	}
-	return &filters
+	return &filters, nil
 }
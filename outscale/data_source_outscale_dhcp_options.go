@@ -17,7 +17,7 @@ func dataSourceOutscaleDHCPOptions() *schema.Resource {
 		Read: dataSourceOutscaleDHCPOptionsRead,
 
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter": dataSourceFiltersSchemaFor(dhcpOptionFilters),
 			"dhcp_options_set_ids": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -72,6 +72,9 @@ func dataSourceOutscaleDHCPOptionsRead(d *schema.ResourceData, meta interface{})
 	conn := meta.(*OutscaleClient).OSCAPI
 
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), dhcpOptionFilters); err != nil {
+		return err
+	}
 	dhcpIDs, dhcpIDOk := d.GetOk("dhcp_options_set_ids")
 	if !dhcpIDOk && !filtersOk {
 		return fmt.Errorf("One of filters, or dhcp_options_set_id must be provided")
@@ -84,7 +87,11 @@ func dataSourceOutscaleDHCPOptionsRead(d *schema.ResourceData, meta interface{})
 		}
 	}
 	if filtersOk {
-		params.Filters = buildOutscaleDataSourceDHCPOptionFilters(filters.(*schema.Set))
+		var err error
+		params.Filters, err = buildOutscaleDataSourceDHCPOptionFilters(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
 	}
 
 	var resp oscgo.ReadDhcpOptionsResponse
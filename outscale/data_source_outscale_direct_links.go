@@ -0,0 +1,129 @@
+package outscale
+
+import (
+	"fmt"
+
+	oscgo "github.com/outscale/osc-sdk-go/v2"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceOutscaleDirectLinks() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceOutscaleDirectLinksRead,
+		Schema: map[string]*schema.Schema{
+			"filter": dataSourceFiltersSchemaFor(directLinkFilters),
+			"direct_links": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"bandwidth": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"direct_link_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"direct_link_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"location": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"request_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceOutscaleDirectLinksRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*OutscaleClient).OSCAPI
+
+	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), directLinkFilters); err != nil {
+		return err
+	}
+
+	var params *oscgo.FiltersDirectLink
+	if filtersOk {
+		var err error
+		params, err = buildOutscaleDataSourceDirectLinkFilters(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
+	}
+
+	directLinks, requestID, err := readOutscaleDirectLinks(conn, params)
+	if err != nil {
+		return fmt.Errorf("error reading Outscale direct links: %s", err)
+	}
+
+	links := make([]map[string]interface{}, len(directLinks))
+	for i, directLink := range directLinks {
+		links[i] = map[string]interface{}{
+			"account_id":       directLink.GetAccountId(),
+			"bandwidth":        directLink.GetBandwidth(),
+			"direct_link_id":   directLink.GetDirectLinkId(),
+			"direct_link_name": directLink.GetDirectLinkName(),
+			"location":         directLink.GetLocation(),
+			"region_name":      directLink.GetRegionName(),
+			"state":            directLink.GetState(),
+		}
+	}
+
+	if err := d.Set("direct_links", links); err != nil {
+		return err
+	}
+	if err := d.Set("request_id", requestID); err != nil {
+		return err
+	}
+
+	d.SetId(resource.UniqueId())
+	return nil
+}
+
+// directLinkFilters are the filters supported by the direct link data sources.
+var directLinkFilters = dataSourceFilters{
+	"direct_link_ids": filterString,
+}
+
+func buildOutscaleDataSourceDirectLinkFilters(set *schema.Set) (*oscgo.FiltersDirectLink, error) {
+	var filters oscgo.FiltersDirectLink
+	for _, v := range set.List() {
+		m := v.(map[string]interface{})
+		var filterValues []string
+		for _, e := range m["values"].([]interface{}) {
+			filterValues = append(filterValues, e.(string))
+		}
+
+		switch name := m["name"].(string); name {
+		case "direct_link_ids":
+			filters.SetDirectLinkIds(filterValues)
+		default:
+			return nil, fmt.Errorf("unknown filter name %q", name)
+		}
+	}
+	return &filters, nil
+}
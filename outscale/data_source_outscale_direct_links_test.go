@@ -0,0 +1,38 @@
+package outscale
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccOutscaleDirectLinksDataSource_basic(t *testing.T) {
+	name := fmt.Sprintf("terraform-direct-link-%d", acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOutscaleDirectLinksDataSourceConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.outscale_direct_links.links", "direct_links.#", "1"),
+					resource.TestCheckResourceAttr("data.outscale_direct_links.links", "direct_links.0.direct_link_name", name),
+				),
+			},
+		},
+	})
+}
+
+func testAccOutscaleDirectLinksDataSourceConfig(name string) string {
+	return testAccOutscaleDirectLinkConfig(name) + `
+		data "outscale_direct_links" "links" {
+			filter {
+				name   = "direct_link_ids"
+				values = ["${outscale_direct_link.link.id}"]
+			}
+		}
+	`
+}
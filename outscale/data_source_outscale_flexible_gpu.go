@@ -3,7 +3,6 @@ package outscale
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 	"time"
 
@@ -19,7 +18,7 @@ func dataSourceOutscaleOAPIFlexibleGpu() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceOutscaleOAPIFlexibleGpuRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter": dataSourceFiltersSchemaFor(flexibleGpuFilters),
 			"request_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -61,6 +60,9 @@ func dataSourceOutscaleOAPIFlexibleGpuRead(d *schema.ResourceData, meta interfac
 	conn := meta.(*OutscaleClient).OSCAPI
 
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), flexibleGpuFilters); err != nil {
+		return err
+	}
 	flexID, IDOk := d.GetOk("flexible_gpu_id")
 
 	if !filtersOk && !IDOk {
@@ -73,10 +75,13 @@ func dataSourceOutscaleOAPIFlexibleGpuRead(d *schema.ResourceData, meta interfac
 		FlexibleGpuIds: &[]string{flexID.(string)},
 	}
 
-	req.SetFilters(buildOutscaleOAPIDataSourceFlexibleGpuFilters(filters.(*schema.Set)))
+	filtersReq, err := buildOutscaleOAPIDataSourceFlexibleGpuFilters(filters.(*schema.Set))
+	if err != nil {
+		return err
+	}
+	req.SetFilters(filtersReq)
 
 	var resp oscgo.ReadFlexibleGpusResponse
-	var err error
 
 	err = resource.Retry(30*time.Second, func() *resource.RetryError {
 		resp, _, err = conn.FlexibleGpuApi.ReadFlexibleGpus(
@@ -125,7 +130,18 @@ func dataSourceOutscaleOAPIFlexibleGpuRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func buildOutscaleOAPIDataSourceFlexibleGpuFilters(set *schema.Set) oscgo.FiltersFlexibleGpu {
+// flexibleGpuFilters are the filters supported by the flexible GPU data sources.
+var flexibleGpuFilters = dataSourceFilters{
+	"delete_on_vm_deletion": filterBool,
+	"flexible_gpu_ids":      filterString,
+	"generations":           filterString,
+	"model_names":           filterString,
+	"states":                filterString,
+	"subregion_names":       filterString,
+	"vm_ids":                filterString,
+}
+
+func buildOutscaleOAPIDataSourceFlexibleGpuFilters(set *schema.Set) (oscgo.FiltersFlexibleGpu, error) {
 	var filters oscgo.FiltersFlexibleGpu
 	for _, v := range set.List() {
 		m := v.(map[string]interface{})
@@ -150,8 +166,8 @@ func buildOutscaleOAPIDataSourceFlexibleGpuFilters(set *schema.Set) oscgo.Filter
 		case "vm_ids":
 			filters.SetVmIds(filterValues)
 		default:
-			log.Printf("[Debug] Unknown Filter Name: %s.", name)
+			return oscgo.FiltersFlexibleGpu{}, fmt.Errorf("unknown filter name %q", name)
 		}
 	}
-	return filters
+	return filters, nil
 }
@@ -14,7 +14,7 @@ func dataSourceOutscaleOAPIFlexibleGpuCatalog() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceOutscaleOAPIFlexibleGpuCatalogRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter": dataSourceFiltersSchemaFor(nil),
 			"flexible_gpu_catalog": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -17,7 +17,7 @@ func dataSourceOutscaleOAPIFlexibleGpus() *schema.Resource {
 		Read: dataSourceOutscaleOAPIFlexibleGpusRead,
 
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter": dataSourceFiltersSchemaFor(flexibleGpuFilters),
 			"flexible_gpus": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -66,6 +66,9 @@ func dataSourceOutscaleOAPIFlexibleGpusRead(d *schema.ResourceData, meta interfa
 
 	conn := meta.(*OutscaleClient).OSCAPI
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), flexibleGpuFilters); err != nil {
+		return err
+	}
 	_, IDOk := d.GetOk("flexible_gpu_id")
 
 	if !filtersOk && !IDOk {
@@ -73,10 +76,13 @@ func dataSourceOutscaleOAPIFlexibleGpusRead(d *schema.ResourceData, meta interfa
 	}
 
 	req := oscgo.ReadFlexibleGpusRequest{}
-	req.SetFilters(buildOutscaleOAPIDataSourceFlexibleGpuFilters(filters.(*schema.Set)))
+	filtersReq, err := buildOutscaleOAPIDataSourceFlexibleGpuFilters(filters.(*schema.Set))
+	if err != nil {
+		return err
+	}
+	req.SetFilters(filtersReq)
 
 	var resp oscgo.ReadFlexibleGpusResponse
-	var err error
 
 	err = resource.Retry(30*time.Second, func() *resource.RetryError {
 		resp, _, err = conn.FlexibleGpuApi.ReadFlexibleGpus(
@@ -17,7 +17,7 @@ func dataSourceOutscaleOAPIImage() *schema.Resource {
 		Read: dataSourceOutscaleOAPIImageRead,
 
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter": dataSourceFiltersSchemaFor(imageFilters),
 			"permission": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -29,6 +29,11 @@ func dataSourceOutscaleOAPIImage() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"most_recent": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			// Computed values.
 			"architecture": {
 				Type:     schema.TypeString,
@@ -157,6 +162,9 @@ func dataSourceOutscaleOAPIImageRead(d *schema.ResourceData, meta interface{}) e
 	conn := meta.(*OutscaleClient).OSCAPI
 
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), imageFilters); err != nil {
+		return err
+	}
 	executableUsers, executableUsersOk := d.GetOk("permission")
 	ai, aisOk := d.GetOk("account_id")
 	imageID, imageIDOk := d.GetOk("image_id")
@@ -166,7 +174,11 @@ func dataSourceOutscaleOAPIImageRead(d *schema.ResourceData, meta interface{}) e
 
 	filtersReq := &oscgo.FiltersImage{}
 	if filtersOk {
-		filtersReq = buildOutscaleOAPIDataSourceImagesFilters(filters.(*schema.Set))
+		var err error
+		filtersReq, err = buildOutscaleOAPIDataSourceImagesFilters(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
 	}
 	if imageIDOk {
 		filtersReq.SetImageIds([]string{imageID.(string)})
@@ -203,7 +215,10 @@ func dataSourceOutscaleOAPIImageRead(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("your query returned no results, please change your search criteria and try again")
 	}
 	if len(images) > 1 {
-		return fmt.Errorf("your query returned more than one result, please try a more specific search criteria")
+		if !d.Get("most_recent").(bool) {
+			return fmt.Errorf("your query returned more than one result, please try a more specific search criteria, or set most_recent to true")
+		}
+		sortOAPIImages(images, "creation_date", "desc")
 	}
 
 	return resourceDataAttrSetter(d, func(set AttributeSetter) error {
@@ -3,7 +3,6 @@ package outscale
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 	"time"
 
@@ -26,7 +25,7 @@ func dataSourceOutscaleOAPIImageExportTask() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter": dataSourceFiltersSchemaFor(imageExportTaskFilters),
 			"dry_run": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -89,10 +88,17 @@ func dataSourceOAPISnapshotImageTaskRead(d *schema.ResourceData, meta interface{
 	conn := meta.(*OutscaleClient).OSCAPI
 
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), imageExportTaskFilters); err != nil {
+		return err
+	}
 
 	filtersReq := &oscgo.FiltersExportTask{}
 	if filtersOk {
-		filtersReq = buildOutscaleOSCAPIDataSourceImageExportTaskFilters(filters.(*schema.Set))
+		var err error
+		filtersReq, err = buildOutscaleOSCAPIDataSourceImageExportTaskFilters(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
 	}
 
 	var resp oscgo.ReadImageExportTasksResponse
@@ -161,7 +167,12 @@ func dataSourceOAPISnapshotImageTaskRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func buildOutscaleOSCAPIDataSourceImageExportTaskFilters(set *schema.Set) *oscgo.FiltersExportTask {
+// imageExportTaskFilters are the filters supported by the image export task data sources.
+var imageExportTaskFilters = dataSourceFilters{
+	"task_ids": filterString,
+}
+
+func buildOutscaleOSCAPIDataSourceImageExportTaskFilters(set *schema.Set) (*oscgo.FiltersExportTask, error) {
 	var filters oscgo.FiltersExportTask
 	for _, v := range set.List() {
 		m := v.(map[string]interface{})
@@ -174,8 +185,8 @@ func buildOutscaleOSCAPIDataSourceImageExportTaskFilters(set *schema.Set) *oscgo
 		case "task_ids":
 			filters.TaskIds = &filterValues
 		default:
-			log.Printf("[Debug] Unknown Filter Name: %s.", name)
+			return nil, fmt.Errorf("unknown filter name %q", name)
 		}
 	}
-	return &filters
+	return &filters, nil
 }
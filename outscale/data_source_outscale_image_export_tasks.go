@@ -25,7 +25,7 @@ func dataSourceOutscaleOAPIImageExportTasks() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter": dataSourceFiltersSchemaFor(imageExportTaskFilters),
 			"dry_run": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -96,10 +96,17 @@ func dataSourceOAPIImageExportTasksRead(d *schema.ResourceData, meta interface{}
 	conn := meta.(*OutscaleClient).OSCAPI
 
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), imageExportTaskFilters); err != nil {
+		return err
+	}
 
 	filtersReq := &oscgo.FiltersExportTask{}
 	if filtersOk {
-		filtersReq = buildOutscaleOSCAPIDataSourceImageExportTaskFilters(filters.(*schema.Set))
+		var err error
+		filtersReq, err = buildOutscaleOSCAPIDataSourceImageExportTaskFilters(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
 	}
 
 	var resp oscgo.ReadImageExportTasksResponse
@@ -3,7 +3,7 @@ package outscale
 import (
 	"context"
 	"fmt"
-	"log"
+	"sort"
 	"strings"
 	"time"
 
@@ -12,6 +12,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/terraform-providers/terraform-provider-outscale/utils"
 )
 
@@ -20,7 +21,18 @@ func dataSourceOutscaleOAPIImages() *schema.Resource {
 		Read: dataSourceOutscaleOAPIImagesRead,
 
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter": dataSourceFiltersSchemaFor(imageFilters),
+			"sort_by": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(imageSortKeys(), false),
+			},
+			"sort_order": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "asc",
+				ValidateFunc: validation.StringInSlice([]string{"asc", "desc"}, false),
+			},
 			"permissions": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -176,6 +188,9 @@ func dataSourceOutscaleOAPIImagesRead(d *schema.ResourceData, meta interface{})
 
 	executableUsers, executableUsersOk := d.GetOk("permissions")
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), imageFilters); err != nil {
+		return err
+	}
 	aids, ownersOk := d.GetOk("account_ids")
 	if !executableUsersOk && !filtersOk && !ownersOk {
 		return fmt.Errorf("One of executable_users, filters, or account_ids must be assigned")
@@ -183,7 +198,11 @@ func dataSourceOutscaleOAPIImagesRead(d *schema.ResourceData, meta interface{})
 
 	filtersReq := &oscgo.FiltersImage{}
 	if filtersOk {
-		filtersReq = buildOutscaleOAPIDataSourceImagesFilters(filters.(*schema.Set))
+		var err error
+		filtersReq, err = buildOutscaleOAPIDataSourceImagesFilters(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
 	}
 	if ownersOk {
 		filtersReq.SetAccountIds([]string{aids.(string)})
@@ -212,6 +231,9 @@ func dataSourceOutscaleOAPIImagesRead(d *schema.ResourceData, meta interface{})
 	}
 
 	images := resp.GetImages()
+	if sortBy, ok := d.GetOk("sort_by"); ok {
+		sortOAPIImages(images, sortBy.(string), d.Get("sort_order").(string))
+	}
 
 	return resourceDataAttrSetter(d, func(set AttributeSetter) error {
 		d.SetId(resource.UniqueId())
@@ -243,7 +265,73 @@ func dataSourceOutscaleOAPIImagesRead(d *schema.ResourceData, meta interface{})
 	})
 }
 
-func buildOutscaleOAPIDataSourceImagesFilters(set *schema.Set) *oscgo.FiltersImage {
+// imageComparators compare two images on the attribute named by the key, they are used to
+// sort the images of the data sources.
+var imageComparators = map[string]func(a, b oscgo.Image) int{
+	"creation_date": func(a, b oscgo.Image) int {
+		return strings.Compare(a.GetCreationDate(), b.GetCreationDate())
+	},
+	"image_id": func(a, b oscgo.Image) int {
+		return strings.Compare(a.GetImageId(), b.GetImageId())
+	},
+	"image_name": func(a, b oscgo.Image) int {
+		return strings.Compare(a.GetImageName(), b.GetImageName())
+	},
+}
+
+func imageSortKeys() []string {
+	keys := make([]string, 0, len(imageComparators))
+	for key := range imageComparators {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// sortOAPIImages sorts the images in place by sortBy, the image ID breaks ties so the order
+// stays the same across reads. Creation dates are ISO 8601 UTC timestamps, they sort as strings.
+func sortOAPIImages(images []oscgo.Image, sortBy, sortOrder string) {
+	compare := imageComparators[sortBy]
+	sort.Slice(images, func(i, j int) bool {
+		c := compare(images[i], images[j])
+		if c == 0 {
+			c = strings.Compare(images[i].GetImageId(), images[j].GetImageId())
+		}
+		if sortOrder == "desc" {
+			return c > 0
+		}
+		return c < 0
+	})
+}
+
+// imageFilters are the filters supported by the image data sources.
+var imageFilters = dataSourceFilters{
+	"account_aliases": filterString,
+	"account_ids":     filterString,
+	"architectures":   filterString,
+	"block_device_mapping_delete_on_vm_deletion": filterBool,
+	"block_device_mapping_device_names":          filterString,
+	"block_device_mapping_snapshot_ids":          filterString,
+	"block_device_mapping_volume_sizes":          filterInt,
+	"block_device_mapping_volume_types":          filterString,
+	"descriptions":                               filterString,
+	"file_locations":                             filterString,
+	"hypervisors":                                filterString,
+	"image_ids":                                  filterString,
+	"image_names":                                filterString,
+	"permissions_to_launch_account_ids":          filterString,
+	"permissions_to_launch_global_permission":    filterBool,
+	"product_codes":                              filterString,
+	"root_device_names":                          filterString,
+	"root_device_types":                          filterString,
+	"states":                                     filterString,
+	"tag_keys":                                   filterString,
+	"tag_values":                                 filterString,
+	"tags":                                       filterString,
+	"virtualization_types":                       filterString,
+}
+
+func buildOutscaleOAPIDataSourceImagesFilters(set *schema.Set) (*oscgo.FiltersImage, error) {
 	filters := &oscgo.FiltersImage{}
 	for _, v := range set.List() {
 		m := v.(map[string]interface{})
@@ -301,10 +389,10 @@ func buildOutscaleOAPIDataSourceImagesFilters(set *schema.Set) *oscgo.FiltersIma
 		case "virtualization_types":
 			filters.SetVirtualizationTypes(filterValues)
 		default:
-			log.Printf("[Debug] Unknown Filter Name: %s.", name)
+			return nil, fmt.Errorf("unknown filter name %q", name)
 		}
 	}
-	return filters
+	return filters, nil
 }
 
 func expandStringValueList(configured []interface{}) []string {
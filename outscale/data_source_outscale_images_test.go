@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	oscgo "github.com/outscale/osc-sdk-go/v2"
 )
 
 func TestAccOutscaleOAPIImagesDataSource_Instance(t *testing.T) {
@@ -31,6 +32,27 @@ func TestAccOutscaleOAPIImagesDataSource_Instance(t *testing.T) {
 	})
 }
 
+func TestSortOAPIImages(t *testing.T) {
+	images := []oscgo.Image{
+		{ImageId: oscgo.PtrString("ami-2"), ImageName: oscgo.PtrString("base-ubuntu-b"), CreationDate: oscgo.PtrString("2021-02-01T10:00:00.000Z")},
+		{ImageId: oscgo.PtrString("ami-3"), ImageName: oscgo.PtrString("base-ubuntu-a"), CreationDate: oscgo.PtrString("2021-03-01T10:00:00.000Z")},
+		{ImageId: oscgo.PtrString("ami-1"), ImageName: oscgo.PtrString("base-ubuntu-a"), CreationDate: oscgo.PtrString("2021-01-01T10:00:00.000Z")},
+	}
+
+	sortOAPIImages(images, "creation_date", "desc")
+	if images[0].GetImageId() != "ami-3" || images[2].GetImageId() != "ami-1" {
+		t.Fatalf("expected the most recent image first, got %s, %s, %s",
+			images[0].GetImageId(), images[1].GetImageId(), images[2].GetImageId())
+	}
+
+	// Images with the same name are ordered by ID.
+	sortOAPIImages(images, "image_name", "asc")
+	if images[0].GetImageId() != "ami-1" || images[1].GetImageId() != "ami-3" || images[2].GetImageId() != "ami-2" {
+		t.Fatalf("expected images sorted by name then ID, got %s, %s, %s",
+			images[0].GetImageId(), images[1].GetImageId(), images[2].GetImageId())
+	}
+}
+
 func testAccCheckOutscaleOAPIImagesDataSourceID(n string) resource.TestCheckFunc {
 	// Wait for IAM role
 	return func(s *terraform.State) error {
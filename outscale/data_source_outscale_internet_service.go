@@ -18,7 +18,7 @@ func datasourceOutscaleOAPIInternetService() *schema.Resource {
 	return &schema.Resource{
 		Read: datasourceOutscaleOAPIInternetServiceRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter": dataSourceFiltersSchemaFor(internetServiceFilters),
 			"state": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -44,6 +44,9 @@ func datasourceOutscaleOAPIInternetServiceRead(d *schema.ResourceData, meta inte
 	conn := meta.(*OutscaleClient).OSCAPI
 
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), internetServiceFilters); err != nil {
+		return err
+	}
 	internetID, insternetIDOk := d.GetOk("internet_service_id")
 
 	if !filtersOk && !insternetIDOk {
@@ -61,7 +64,11 @@ func datasourceOutscaleOAPIInternetServiceRead(d *schema.ResourceData, meta inte
 	}
 
 	if filtersOk {
-		params.Filters = buildOutscaleOSCAPIDataSourceInternetServiceFilters(filters.(*schema.Set))
+		var err error
+		params.Filters, err = buildOutscaleOSCAPIDataSourceInternetServiceFilters(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
 	}
 
 	var resp oscgo.ReadInternetServicesResponse
@@ -110,7 +117,17 @@ func datasourceOutscaleOAPIInternetServiceRead(d *schema.ResourceData, meta inte
 	return d.Set("tags", tagsOSCAPIToMap(result.GetTags()))
 }
 
-func buildOutscaleOSCAPIDataSourceInternetServiceFilters(set *schema.Set) *oscgo.FiltersInternetService {
+// internetServiceFilters are the filters supported by the internet service data sources.
+var internetServiceFilters = dataSourceFilters{
+	"internet_service_ids": filterString,
+	"link_net_ids":         filterString,
+	"link_states":          filterString,
+	"tags":                 filterString,
+	"tag_keys":             filterString,
+	"tag_values":           filterString,
+}
+
+func buildOutscaleOSCAPIDataSourceInternetServiceFilters(set *schema.Set) (*oscgo.FiltersInternetService, error) {
 	var filters oscgo.FiltersInternetService
 	for _, v := range set.List() {
 		m := v.(map[string]interface{})
@@ -133,8 +150,8 @@ func buildOutscaleOSCAPIDataSourceInternetServiceFilters(set *schema.Set) *oscgo
 		case "tag_values":
 			filters.SetTagValues(filterValues)
 		default:
-			log.Printf("[Debug] Unknown Filter Name: %s.", name)
+			return nil, fmt.Errorf("unknown filter name %q", name)
 		}
 	}
-	return &filters
+	return &filters, nil
 }
@@ -17,7 +17,7 @@ func datasourceOutscaleOAPIInternetServices() *schema.Resource {
 	return &schema.Resource{
 		Read: datasourceOutscaleOAPIInternetServicesRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter": dataSourceFiltersSchemaFor(internetServiceFilters),
 			"internet_service_ids": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -58,6 +58,9 @@ func datasourceOutscaleOAPIInternetServicesRead(d *schema.ResourceData, meta int
 	conn := meta.(*OutscaleClient).OSCAPI
 
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), internetServiceFilters); err != nil {
+		return err
+	}
 	internetID, internetIDOk := d.GetOk("internet_service_ids")
 
 	if !filtersOk && !internetIDOk {
@@ -78,7 +81,11 @@ func datasourceOutscaleOAPIInternetServicesRead(d *schema.ResourceData, meta int
 	}
 
 	if filtersOk {
-		params.Filters = buildOutscaleOSCAPIDataSourceInternetServiceFilters(filters.(*schema.Set))
+		var err error
+		params.Filters, err = buildOutscaleOSCAPIDataSourceInternetServiceFilters(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
 	}
 
 	var resp oscgo.ReadInternetServicesResponse
@@ -3,7 +3,6 @@ package outscale
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 	"time"
 
@@ -27,9 +26,16 @@ func datasourceOutscaleOApiKeyPairRead(d *schema.ResourceData, meta interface{})
 	}
 
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), keypairFilters); err != nil {
+		return err
+	}
 
 	if filtersOk {
-		req.SetFilters(buildOutscaleOAPIKeyPairsDataSourceFilters(filters.(*schema.Set)))
+		filtersReq, err := buildOutscaleOAPIKeyPairsDataSourceFilters(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
+		req.SetFilters(filtersReq)
 	}
 
 	var resp oscgo.ReadKeypairsResponse
@@ -83,7 +89,7 @@ func datasourceOutscaleOAPIKeyPair() *schema.Resource {
 		Read: datasourceOutscaleOApiKeyPairRead,
 
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter": dataSourceFiltersSchemaFor(keypairFilters),
 			// Attributes
 			"keypair_name": {
 				Type:     schema.TypeString,
@@ -102,7 +108,13 @@ func datasourceOutscaleOAPIKeyPair() *schema.Resource {
 	}
 }
 
-func buildOutscaleOAPIKeyPairsDataSourceFilters(set *schema.Set) oscgo.FiltersKeypair {
+// keypairFilters are the filters supported by the keypair data sources.
+var keypairFilters = dataSourceFilters{
+	"keypair_fingerprints": filterString,
+	"keypair_names":        filterString,
+}
+
+func buildOutscaleOAPIKeyPairsDataSourceFilters(set *schema.Set) (oscgo.FiltersKeypair, error) {
 	var filters oscgo.FiltersKeypair
 	for _, v := range set.List() {
 		m := v.(map[string]interface{})
@@ -117,8 +129,8 @@ func buildOutscaleOAPIKeyPairsDataSourceFilters(set *schema.Set) oscgo.FiltersKe
 		case "keypair_names":
 			filters.SetKeypairNames(filterValues)
 		default:
-			log.Printf("[Debug] Unknown Filter Name: %s.", name)
+			return oscgo.FiltersKeypair{}, fmt.Errorf("unknown filter name %q", name)
 		}
 	}
-	return filters
+	return filters, nil
 }
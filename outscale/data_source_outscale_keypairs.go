@@ -32,9 +32,16 @@ func datasourceOutscaleOAPiKeyPairsRead(d *schema.ResourceData, meta interface{}
 	}
 
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), keypairFilters); err != nil {
+		return err
+	}
 
 	if filtersOk {
-		req.SetFilters(buildOutscaleOAPIKeyPairsDataSourceFilters(filters.(*schema.Set)))
+		filtersReq, err := buildOutscaleOAPIKeyPairsDataSourceFilters(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
+		req.SetFilters(filtersReq)
 	}
 
 	var resp oscgo.ReadKeypairsResponse
@@ -89,7 +96,7 @@ func datasourceOutscaleOAPIKeyPairs() *schema.Resource {
 		Read: datasourceOutscaleOAPiKeyPairsRead,
 
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter": dataSourceFiltersSchemaFor(keypairFilters),
 			// Attributes
 			"keypair_names": {
 				Type:     schema.TypeList,
@@ -3,7 +3,6 @@ package outscale
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 	"time"
 
@@ -124,6 +123,10 @@ func attrLBchema() map[string]*schema.Schema {
 			Computed: true,
 			ForceNew: true,
 		},
+		"public_ip": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
 		"application_sticky_cookie_policies": {
 			Type:     schema.TypeList,
 			Computed: true,
@@ -145,6 +148,10 @@ func attrLBchema() map[string]*schema.Schema {
 			Computed: true,
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
+					"cookie_expiration_period": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
 					"policy_name": {
 						Type:     schema.TypeString,
 						Computed: true,
@@ -159,9 +166,9 @@ func attrLBchema() map[string]*schema.Schema {
 	}
 }
 
-func getDataSourceSchemas(attrsSchema map[string]*schema.Schema) map[string]*schema.Schema {
+func getDataSourceSchemas(attrsSchema map[string]*schema.Schema, supported dataSourceFilters) map[string]*schema.Schema {
 	wholeSchema := map[string]*schema.Schema{
-		"filter": dataSourceFiltersSchema(),
+		"filter": dataSourceFiltersSchemaFor(supported),
 	}
 
 	for k, v := range attrsSchema {
@@ -175,11 +182,18 @@ func getDataSourceSchemas(attrsSchema map[string]*schema.Schema) map[string]*sch
 func dataSourceOutscaleOAPILoadBalancer() *schema.Resource {
 	return &schema.Resource{
 		Read:   dataSourceOutscaleOAPILoadBalancerRead,
-		Schema: getDataSourceSchemas(attrLBchema()),
+		Schema: getDataSourceSchemas(attrLBchema(), lbFilters),
 	}
 }
 
-func buildOutscaleDataSourceLBFilters(set *schema.Set) *oscgo.FiltersLoadBalancer {
+// lbFilters are the filters supported by the load balancer data sources.
+// load_balancer_name is kept for the configurations written for previous versions.
+var lbFilters = dataSourceFilters{
+	"load_balancer_name":  filterString,
+	"load_balancer_names": filterString,
+}
+
+func buildOutscaleDataSourceLBFilters(set *schema.Set) (*oscgo.FiltersLoadBalancer, error) {
 	filters := new(oscgo.FiltersLoadBalancer)
 
 	for _, v := range set.List() {
@@ -190,14 +204,13 @@ func buildOutscaleDataSourceLBFilters(set *schema.Set) *oscgo.FiltersLoadBalance
 		}
 
 		switch name := m["name"].(string); name {
-		case "load_balancer_name":
+		case "load_balancer_name", "load_balancer_names":
 			filters.LoadBalancerNames = &filterValues
 		default:
-			filters.LoadBalancerNames = &filterValues
-			log.Printf("[Debug] Unknown Filter Name: %s. default to 'load_balancer_name'", name)
+			return nil, fmt.Errorf("unknown filter name %q", name)
 		}
 	}
-	return filters
+	return filters, nil
 }
 
 func readLbs(conn *oscgo.APIClient, d *schema.ResourceData) (*oscgo.ReadLoadBalancersResponse, *string, error) {
@@ -207,6 +220,9 @@ func readLbs(conn *oscgo.APIClient, d *schema.ResourceData) (*oscgo.ReadLoadBala
 func readLbs_(conn *oscgo.APIClient, d *schema.ResourceData, t schema.ValueType) (*oscgo.ReadLoadBalancersResponse, *string, error) {
 	ename, nameOk := d.GetOk("load_balancer_name")
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), lbFilters); err != nil {
+		return nil, nil, err
+	}
 	filter := new(oscgo.FiltersLoadBalancer)
 
 	if !nameOk && !filtersOk {
@@ -214,7 +230,11 @@ func readLbs_(conn *oscgo.APIClient, d *schema.ResourceData, t schema.ValueType)
 	}
 
 	if filtersOk {
-		filter = buildOutscaleDataSourceLBFilters(filters.(*schema.Set))
+		var err error
+		filter, err = buildOutscaleDataSourceLBFilters(filters.(*schema.Set))
+		if err != nil {
+			return nil, nil, err
+		}
 	} else if t == schema.TypeString {
 		elbName := ename.(string)
 		filter = &oscgo.FiltersLoadBalancer{
@@ -226,7 +246,10 @@ func readLbs_(conn *oscgo.APIClient, d *schema.ResourceData, t schema.ValueType)
 		}
 
 	}
-	elbName := (*filter.LoadBalancerNames)[0]
+	if len(filter.GetLoadBalancerNames()) == 0 {
+		return nil, nil, fmt.Errorf("One of filters, or load_balancer_name must be assigned")
+	}
+	elbName := filter.GetLoadBalancerNames()[0]
 
 	req := oscgo.ReadLoadBalancersRequest{
 		Filters: filter,
@@ -311,6 +334,7 @@ func dataSourceOutscaleOAPILoadBalancerRead(d *schema.ResourceData, meta interfa
 		lbc := make([]map[string]interface{}, len(*lb.LoadBalancerStickyCookiePolicies))
 		for k, v := range *lb.LoadBalancerStickyCookiePolicies {
 			a := make(map[string]interface{})
+			a["cookie_expiration_period"] = v.GetCookieExpirationPeriod()
 			a["policy_name"] = v.PolicyName
 			lbc[k] = a
 		}
@@ -345,6 +369,7 @@ func dataSourceOutscaleOAPILoadBalancerRead(d *schema.ResourceData, meta interfa
 	}
 
 	d.Set("net_id", lb.NetId)
+	d.Set("public_ip", lb.GetPublicIp())
 	d.Set("source_security_group", ssg)
 	d.Set("subnets", flattenStringList(lb.Subnets))
 	d.SetId(*lb.LoadBalancerName)
@@ -3,7 +3,6 @@ package outscale
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 	"time"
 
@@ -59,7 +58,7 @@ func attrLBListenerRule() map[string]*schema.Schema {
 func dataSourceOutscaleOAPILoadBalancerLDRule() *schema.Resource {
 	return &schema.Resource{
 		Read:   dataSourceOutscaleOAPILoadBalancerLDRuleRead,
-		Schema: getDataSourceSchemas(attrLBListenerRule()),
+		Schema: getDataSourceSchemas(attrLBListenerRule(), listenerRuleFilters),
 	}
 }
 
@@ -68,6 +67,9 @@ func dataSourceOutscaleOAPILoadBalancerLDRuleRead(d *schema.ResourceData, meta i
 
 	lrNamei, nameOk := d.GetOk("listener_rule_name")
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), listenerRuleFilters); err != nil {
+		return err
+	}
 	filter := &oscgo.FiltersListenerRule{}
 
 	if !nameOk && !filtersOk {
@@ -75,25 +77,10 @@ func dataSourceOutscaleOAPILoadBalancerLDRuleRead(d *schema.ResourceData, meta i
 	}
 
 	if filtersOk {
-		set := filters.(*schema.Set)
-
-		if set.Len() < 1 {
-			return fmt.Errorf("filter can't be empty")
-		}
-		for _, v := range set.List() {
-			m := v.(map[string]interface{})
-			filterValues := make([]string, 0)
-			for _, e := range m["values"].([]interface{}) {
-				filterValues = append(filterValues, e.(string))
-			}
-
-			switch name := m["name"].(string); name {
-			case "listener_rule_name":
-				filter.ListenerRuleNames = &filterValues
-			default:
-				filter.ListenerRuleNames = &filterValues
-				log.Printf("[Debug] Unknown Filter Name: %s. default to 'load_balancer_name'", name)
-			}
+		var err error
+		filter, err = buildOutscaleDataSourceListenerRuleFilters(filters.(*schema.Set))
+		if err != nil {
+			return err
 		}
 	} else {
 		filter = &oscgo.FiltersListenerRule{
@@ -164,3 +151,30 @@ func dataSourceOutscaleOAPILoadBalancerLDRuleRead(d *schema.ResourceData, meta i
 
 	return nil
 }
+
+// listenerRuleFilters are the filters supported by the listener rule data sources.
+// listener_rule_name is kept for the configurations written for previous versions.
+var listenerRuleFilters = dataSourceFilters{
+	"listener_rule_name":  filterString,
+	"listener_rule_names": filterString,
+}
+
+func buildOutscaleDataSourceListenerRuleFilters(set *schema.Set) (*oscgo.FiltersListenerRule, error) {
+	filters := new(oscgo.FiltersListenerRule)
+
+	for _, v := range set.List() {
+		m := v.(map[string]interface{})
+		filterValues := make([]string, 0)
+		for _, e := range m["values"].([]interface{}) {
+			filterValues = append(filterValues, e.(string))
+		}
+
+		switch name := m["name"].(string); name {
+		case "listener_rule_name", "listener_rule_names":
+			filters.ListenerRuleNames = &filterValues
+		default:
+			return nil, fmt.Errorf("unknown filter name %q", name)
+		}
+	}
+	return filters, nil
+}
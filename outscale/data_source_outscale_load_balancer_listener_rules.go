@@ -3,7 +3,6 @@ package outscale
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 	"time"
 
@@ -67,7 +66,7 @@ func attrLBListenerRules() map[string]*schema.Schema {
 func dataSourceOutscaleOAPILoadBalancerLDRules() *schema.Resource {
 	return &schema.Resource{
 		Read:   dataSourceOutscaleOAPILoadBalancerLDRulesRead,
-		Schema: getDataSourceSchemas(attrLBListenerRules()),
+		Schema: getDataSourceSchemas(attrLBListenerRules(), listenerRuleFilters),
 	}
 }
 
@@ -76,6 +75,9 @@ func dataSourceOutscaleOAPILoadBalancerLDRulesRead(d *schema.ResourceData, meta
 
 	lrNamei, nameOk := d.GetOk("listener_rule_name")
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), listenerRuleFilters); err != nil {
+		return err
+	}
 	filter := &oscgo.FiltersListenerRule{}
 
 	if !nameOk && !filtersOk {
@@ -83,25 +85,10 @@ func dataSourceOutscaleOAPILoadBalancerLDRulesRead(d *schema.ResourceData, meta
 	}
 
 	if filtersOk {
-		set := filters.(*schema.Set)
-
-		if set.Len() < 1 {
-			return fmt.Errorf("filter can't be empty")
-		}
-		for _, v := range set.List() {
-			m := v.(map[string]interface{})
-			filterValues := make([]string, 0)
-			for _, e := range m["values"].([]interface{}) {
-				filterValues = append(filterValues, e.(string))
-			}
-
-			switch name := m["name"].(string); name {
-			case "listener_rule_name":
-				filter.ListenerRuleNames = &filterValues
-			default:
-				filter.ListenerRuleNames = &filterValues
-				log.Printf("[Debug] Unknown Filter Name: %s. default to 'load_balancer_name'", name)
-			}
+		var err error
+		filter, err = buildOutscaleDataSourceListenerRuleFilters(filters.(*schema.Set))
+		if err != nil {
+			return err
 		}
 	} else {
 		filter = &oscgo.FiltersListenerRule{
@@ -16,7 +16,7 @@ func dataSourceOutscaleOAPILBUTags() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceOutscaleOAPILBUTagsRead,
 
-		Schema: getDataSourceSchemas(getDSOAPILBUTagsSchema()),
+		Schema: getDataSourceSchemas(getDSOAPILBUTagsSchema(), nil),
 	}
 }
 
@@ -58,7 +58,7 @@ func dataSourceOutscaleLoadBalancerVmsHeals() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-		}),
+		}, nil),
 	}
 }
 
@@ -71,19 +71,38 @@ func dataSourceOutscaleLoadBalancerVmsHealRead(d *schema.ResourceData,
 		return errors.New("load_balancer_name is require")
 	}
 
-	req := oscgo.ReadVmsHealthRequest{
-		LoadBalancerName: ename.(string),
+	var vmIDs []string
+	if v, ok := d.GetOk("backend_vm_ids"); ok {
+		vmIDs = expandInstanceString(v.([]interface{}))
 	}
 
-	vm_ids, ok := d.GetOk("backend_vm_ids")
-	if ok {
-		vm_ids_i := vm_ids.([]interface{})
-		vm_ids_s := make([]string, 0, len(vm_ids_i))
-		for _, v := range vm_ids_i {
-			vm_ids_s = append(vm_ids_s, v.(string))
-		}
+	health, err := readOAPILBUVmsHealth(conn, ename.(string), vmIDs)
+	if err != nil {
+		return err
+	}
+
+	lbvh := make([]map[string]interface{}, len(health))
+	for k, v := range health {
+		a := make(map[string]interface{})
+		a["description"] = v.Description
+		a["state"] = v.State
+		a["state_reason"] = v.StateReason
+		a["vm_id"] = v.VmId
+		lbvh[k] = a
+	}
+	d.Set("backend_vm_health", lbvh)
+	//  ename.(string) "-heal-" resource.UniqueId()
+	id := ename.(string) + "-heal-"
+	d.SetId(resource.PrefixedUniqueId(id))
+	return nil
+}
 
-		req.BackendVmIds = &vm_ids_s
+func readOAPILBUVmsHealth(conn *oscgo.APIClient, lbName string, vmIDs []string) ([]oscgo.BackendVmHealth, error) {
+	req := oscgo.ReadVmsHealthRequest{
+		LoadBalancerName: lbName,
+	}
+	if len(vmIDs) > 0 {
+		req.BackendVmIds = &vmIDs
 	}
 
 	var resp oscgo.ReadVmsHealthResponse
@@ -105,24 +124,11 @@ func dataSourceOutscaleLoadBalancerVmsHealRead(d *schema.ResourceData,
 		return nil
 	})
 	if err != nil {
-		return fmt.Errorf("Error retrieving Load Balacer Vms Heal: %s", err)
+		return nil, fmt.Errorf("Error retrieving Load Balacer Vms Heal: %s", err)
 	}
 
 	if resp.BackendVmHealth == nil {
-		return fmt.Errorf("lb.BackendVmHealth not found")
-	}
-	lbvh := make([]map[string]interface{}, len(*resp.BackendVmHealth))
-	for k, v := range *resp.BackendVmHealth {
-		a := make(map[string]interface{})
-		a["description"] = v.Description
-		a["state"] = v.State
-		a["state_reason"] = v.StateReason
-		a["vm_id"] = v.VmId
-		lbvh[k] = a
+		return nil, fmt.Errorf("lb.BackendVmHealth not found")
 	}
-	d.Set("backend_vm_health", lbvh)
-	//  ename.(string) "-heal-" resource.UniqueId()
-	id := ename.(string) + "-heal-"
-	d.SetId(resource.PrefixedUniqueId(id))
-	return nil
+	return *resp.BackendVmHealth, nil
 }
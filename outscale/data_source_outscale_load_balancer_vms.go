@@ -24,7 +24,7 @@ func dataSourceOutscaleLoadBalancerVms() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-		}),
+		}, lbFilters),
 	}
 }
 
@@ -130,6 +130,10 @@ func attrLBSchema() map[string]*schema.Schema {
 						Computed: true,
 						Elem: &schema.Resource{
 							Schema: map[string]*schema.Schema{
+								"cookie_expiration_period": {
+									Type:     schema.TypeInt,
+									Computed: true,
+								},
 								"policy_name": {
 									Type:     schema.TypeString,
 									Computed: true,
@@ -172,6 +176,10 @@ func attrLBSchema() map[string]*schema.Schema {
 						Type:     schema.TypeString,
 						Computed: true,
 					},
+					"public_ip": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
 				},
 			},
 		},
@@ -186,7 +194,7 @@ func dataSourceOutscaleOAPILoadBalancers() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceOutscaleOAPILoadBalancersRead,
 
-		Schema: getDataSourceSchemas(attrLBSchema()),
+		Schema: getDataSourceSchemas(attrLBSchema(), lbFilters),
 	}
 }
 
@@ -237,6 +245,7 @@ func dataSourceOutscaleOAPILoadBalancersRead(d *schema.ResourceData, meta interf
 				len(*v.LoadBalancerStickyCookiePolicies))
 			for k, v := range *v.LoadBalancerStickyCookiePolicies {
 				a := make(map[string]interface{})
+				a["cookie_expiration_period"] = v.GetCookieExpirationPeriod()
 				a["policy_name"] = v.PolicyName
 				vc[k] = a
 			}
@@ -267,6 +276,7 @@ func dataSourceOutscaleOAPILoadBalancersRead(d *schema.ResourceData, meta interf
 		l["source_security_group"] = ssg
 		l["subnet_id"] = flattenStringList(v.Subnets)
 		l["net_id"] = v.NetId
+		l["public_ip"] = v.GetPublicIp()
 
 		lbs_ret[k] = l
 	}
@@ -0,0 +1,80 @@
+package outscale
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	oscgo "github.com/outscale/osc-sdk-go/v2"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-outscale/utils"
+)
+
+func dataSourceOutscaleLocations() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceOutscaleLocationsRead,
+		Schema: map[string]*schema.Schema{
+			"locations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"request_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceOutscaleLocationsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*OutscaleClient).OSCAPI
+
+	var resp oscgo.ReadLocationsResponse
+	var err error
+	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		resp, _, err = conn.LocationApi.ReadLocations(context.Background()).ReadLocationsRequest(oscgo.ReadLocationsRequest{}).Execute()
+		if err != nil {
+			if strings.Contains(fmt.Sprint(err), "RequestLimitExceeded:") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error reading Outscale locations: %s", utils.GetErrorResponse(err))
+	}
+
+	locations := make([]map[string]interface{}, len(resp.GetLocations()))
+	for i, location := range resp.GetLocations() {
+		locations[i] = map[string]interface{}{
+			"code": location.GetCode(),
+			"name": location.GetName(),
+		}
+	}
+
+	if err := d.Set("locations", locations); err != nil {
+		return err
+	}
+	if err := d.Set("request_id", resp.ResponseContext.GetRequestId()); err != nil {
+		return err
+	}
+
+	d.SetId(resource.UniqueId())
+	return nil
+}
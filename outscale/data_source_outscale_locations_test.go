@@ -0,0 +1,23 @@
+package outscale
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccOutscaleLocationsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "outscale_locations" "locations" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.outscale_locations.locations", "locations.0.code"),
+					resource.TestCheckResourceAttrSet("data.outscale_locations.locations", "locations.0.name"),
+				),
+			},
+		},
+	})
+}
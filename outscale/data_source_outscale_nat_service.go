@@ -3,7 +3,6 @@ package outscale
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 	"time"
 
@@ -18,7 +17,7 @@ func dataSourceOutscaleOAPINatService() *schema.Resource {
 		Read: dataSourceOutscaleOAPINatServiceRead,
 
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter": dataSourceFiltersSchemaFor(natServiceFilters),
 			"nat_service_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -65,6 +64,9 @@ func dataSourceOutscaleOAPINatServiceRead(d *schema.ResourceData, meta interface
 	conn := meta.(*OutscaleClient).OSCAPI
 
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), natServiceFilters); err != nil {
+		return err
+	}
 	natGatewayID, natGatewayIDOK := d.GetOk("nat_service_id")
 
 	if !filtersOk && !natGatewayIDOK {
@@ -74,7 +76,11 @@ func dataSourceOutscaleOAPINatServiceRead(d *schema.ResourceData, meta interface
 	params := oscgo.ReadNatServicesRequest{}
 
 	if filtersOk {
-		params.SetFilters(buildOutscaleOAPINatServiceDataSourceFilters(filters.(*schema.Set)))
+		filtersReq, err := buildOutscaleOAPINatServiceDataSourceFilters(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
+		params.SetFilters(filtersReq)
 	}
 	if natGatewayIDOK && natGatewayID.(string) != "" {
 		filter := oscgo.FiltersNatService{}
@@ -166,7 +172,18 @@ func ngOAPIDescriptionAttributes(d *schema.ResourceData, ng oscgo.NatService) er
 	return nil
 }
 
-func buildOutscaleOAPINatServiceDataSourceFilters(set *schema.Set) oscgo.FiltersNatService {
+// natServiceFilters are the filters supported by the NAT service data sources.
+var natServiceFilters = dataSourceFilters{
+	"nat_service_ids": filterString,
+	"net_ids":         filterString,
+	"states":          filterString,
+	"subnet_ids":      filterString,
+	"tag_keys":        filterString,
+	"tag_values":      filterString,
+	"tags":            filterString,
+}
+
+func buildOutscaleOAPINatServiceDataSourceFilters(set *schema.Set) (oscgo.FiltersNatService, error) {
 	var filters oscgo.FiltersNatService
 	for _, v := range set.List() {
 		m := v.(map[string]interface{})
@@ -191,8 +208,8 @@ func buildOutscaleOAPINatServiceDataSourceFilters(set *schema.Set) oscgo.Filters
 		case "tags":
 			filters.SetTags(filterValues)
 		default:
-			log.Printf("[Debug] Unknown Filter Name: %s.", name)
+			return oscgo.FiltersNatService{}, fmt.Errorf("unknown filter name %q", name)
 		}
 	}
-	return filters
+	return filters, nil
 }
@@ -17,7 +17,7 @@ func dataSourceOutscaleOAPINatServices() *schema.Resource {
 		Read: dataSourceOutscaleOAPINatServicesRead,
 
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter": dataSourceFiltersSchemaFor(natServiceFilters),
 			"nat_service_ids": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -78,6 +78,9 @@ func dataSourceOutscaleOAPINatServicesRead(d *schema.ResourceData, meta interfac
 	conn := meta.(*OutscaleClient).OSCAPI
 
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), natServiceFilters); err != nil {
+		return err
+	}
 	natGatewayID, natGatewayIDOK := d.GetOk("nat_service_ids")
 
 	if !filtersOk && !natGatewayIDOK {
@@ -86,7 +89,11 @@ func dataSourceOutscaleOAPINatServicesRead(d *schema.ResourceData, meta interfac
 
 	params := oscgo.ReadNatServicesRequest{}
 	if filtersOk {
-		params.SetFilters(buildOutscaleOAPINatServiceDataSourceFilters(filters.(*schema.Set)))
+		filtersReq, err := buildOutscaleOAPINatServiceDataSourceFilters(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
+		params.SetFilters(filtersReq)
 	}
 	if natGatewayIDOK {
 		ids := make([]string, len(natGatewayID.([]interface{})))
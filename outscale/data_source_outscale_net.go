@@ -3,7 +3,6 @@ package outscale
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 	"time"
 
@@ -18,7 +17,7 @@ func dataSourceOutscaleOAPIVpc() *schema.Resource {
 		Read: dataSourceOutscaleOAPIVpcRead,
 
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter": dataSourceFiltersSchemaFor(netFilters),
 			"ip_range": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -60,7 +59,14 @@ func dataSourceOutscaleOAPIVpcRead(d *schema.ResourceData, meta interface{}) err
 	req := oscgo.ReadNetsRequest{}
 
 	if v, ok := d.GetOk("filter"); ok {
-		req.SetFilters(buildOutscaleOAPIDataSourceNetFilters(v.(*schema.Set)))
+		if err := validateDataSourceFilters(v.(*schema.Set), netFilters); err != nil {
+			return err
+		}
+		filtersReq, err := buildOutscaleOAPIDataSourceNetFilters(v.(*schema.Set))
+		if err != nil {
+			return err
+		}
+		req.SetFilters(filtersReq)
 	}
 
 	if id := d.Get("net_id"); id != "" {
@@ -114,7 +120,18 @@ func dataSourceOutscaleOAPIVpcRead(d *schema.ResourceData, meta interface{}) err
 	return d.Set("tags", tagsOSCAPIToMap(net.GetTags()))
 }
 
-func buildOutscaleOAPIDataSourceNetFilters(set *schema.Set) oscgo.FiltersNet {
+// netFilters are the filters supported by the Net data sources.
+var netFilters = dataSourceFilters{
+	"dhcp_options_set_ids": filterString,
+	"ip_ranges":            filterString,
+	"net_ids":              filterString,
+	"states":               filterString,
+	"tag_keys":             filterString,
+	"tag_values":           filterString,
+	"tags":                 filterString,
+}
+
+func buildOutscaleOAPIDataSourceNetFilters(set *schema.Set) (oscgo.FiltersNet, error) {
 	var filters oscgo.FiltersNet
 	for _, v := range set.List() {
 		m := v.(map[string]interface{})
@@ -139,8 +156,8 @@ func buildOutscaleOAPIDataSourceNetFilters(set *schema.Set) oscgo.FiltersNet {
 		case "tags":
 			filters.SetTags(filterValues)
 		default:
-			log.Printf("[Debug] Unknown Filter Name: %s.", name)
+			return oscgo.FiltersNet{}, fmt.Errorf("unknown filter name %q", name)
 		}
 	}
-	return filters
+	return filters, nil
 }
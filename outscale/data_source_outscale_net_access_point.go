@@ -61,7 +61,7 @@ func dataSourceOutscaleNetAccessPoint() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceOutscaleNetAccessPointRead,
 
-		Schema: getDataSourceSchemas(napdSchema()),
+		Schema: getDataSourceSchemas(napdSchema(), napFilters),
 	}
 }
 
@@ -70,6 +70,9 @@ func dataSourceOutscaleNetAccessPointRead(d *schema.ResourceData, meta interface
 
 	napid, napidOk := d.GetOk("net_access_point_ids")
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), napFilters); err != nil {
+		return err
+	}
 	filter := new(oscgo.FiltersNetAccessPoint)
 
 	if !napidOk && !filtersOk {
@@ -77,7 +80,11 @@ func dataSourceOutscaleNetAccessPointRead(d *schema.ResourceData, meta interface
 	}
 
 	if filtersOk {
-		filter = buildOutscaleDataSourcesNAPFilters(filters.(*schema.Set))
+		var err error
+		filter, err = buildOutscaleDataSourcesNAPFilters(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
 	} else {
 		filter = &oscgo.FiltersNetAccessPoint{
 			NetAccessPointIds: &[]string{napid.(string)},
@@ -15,7 +15,7 @@ func dataSourceOutscaleOAPINetAccessPointServices() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceOutscaleOAPINetAccessPointServicesRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter": dataSourceFiltersSchemaFor(netAccessPointServiceFilters),
 			"services": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -49,6 +49,9 @@ func dataSourceOutscaleOAPINetAccessPointServicesRead(d *schema.ResourceData, me
 	conn := meta.(*OutscaleClient).OSCAPI
 
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), netAccessPointServiceFilters); err != nil {
+		return err
+	}
 
 	filtersReq := oscgo.FiltersService{}
 	if filtersOk {
@@ -93,6 +96,12 @@ func dataSourceOutscaleOAPINetAccessPointServicesRead(d *schema.ResourceData, me
 	return nil
 }
 
+// netAccessPointServiceFilters are the filters supported by the net access point service data sources.
+var netAccessPointServiceFilters = dataSourceFilters{
+	"service_ids":   filterString,
+	"service_names": filterString,
+}
+
 func buildOutscaleDataSourcesNAPSFilters(set *schema.Set) oscgo.FiltersService {
 	var filters oscgo.FiltersService
 
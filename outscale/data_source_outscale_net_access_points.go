@@ -3,7 +3,6 @@ package outscale
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 	"time"
 
@@ -91,11 +90,24 @@ func dataSourceOutscaleNetAccessPoints() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceOutscaleNetAccessPointsRead,
 
-		Schema: getDataSourceSchemas(napSchema()),
+		Schema: getDataSourceSchemas(napSchema(), napFilters),
 	}
 }
 
-func buildOutscaleDataSourcesNAPFilters(set *schema.Set) *oscgo.FiltersNetAccessPoint {
+// napFilters are the filters supported by the Net access point data sources.
+// net_access_point_id is kept for the configurations written for previous versions.
+var napFilters = dataSourceFilters{
+	"net_access_point_id":  filterString,
+	"net_access_point_ids": filterString,
+	"net_ids":              filterString,
+	"service_names":        filterString,
+	"states":               filterString,
+	"tag_keys":             filterString,
+	"tag_values":           filterString,
+	"tags":                 filterString,
+}
+
+func buildOutscaleDataSourcesNAPFilters(set *schema.Set) (*oscgo.FiltersNetAccessPoint, error) {
 	filters := new(oscgo.FiltersNetAccessPoint)
 
 	for _, v := range set.List() {
@@ -118,14 +130,13 @@ func buildOutscaleDataSourcesNAPFilters(set *schema.Set) *oscgo.FiltersNetAccess
 			filters.TagValues = &filterValues
 		case "tags":
 			filters.Tags = &filterValues
-		case "net_access_point_id":
+		case "net_access_point_id", "net_access_point_ids":
 			filters.NetAccessPointIds = &filterValues
 		default:
-			filters.NetAccessPointIds = &filterValues
-			log.Printf("[Debug] Unknown Filter Name: %s. default to 'net_access_point_id'", name)
+			return nil, fmt.Errorf("unknown filter name %q", name)
 		}
 	}
-	return filters
+	return filters, nil
 }
 
 func dataSourceOutscaleNetAccessPointsRead(d *schema.ResourceData, meta interface{}) error {
@@ -133,6 +144,9 @@ func dataSourceOutscaleNetAccessPointsRead(d *schema.ResourceData, meta interfac
 
 	napid, napidOk := d.GetOk("net_access_point_ids")
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), napFilters); err != nil {
+		return err
+	}
 	filter := new(oscgo.FiltersNetAccessPoint)
 
 	if !napidOk && !filtersOk {
@@ -140,7 +154,11 @@ func dataSourceOutscaleNetAccessPointsRead(d *schema.ResourceData, meta interfac
 	}
 
 	if filtersOk {
-		filter = buildOutscaleDataSourcesNAPFilters(filters.(*schema.Set))
+		var err error
+		filter, err = buildOutscaleDataSourcesNAPFilters(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
 	} else {
 		filter = &oscgo.FiltersNetAccessPoint{
 			NetAccessPointIds: &[]string{napid.(string)},
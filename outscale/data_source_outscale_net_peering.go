@@ -19,7 +19,7 @@ func dataSourceOutscaleOAPILinPeeringConnection() *schema.Resource {
 		Read: dataSourceOutscaleOAPILinPeeringConnectionRead,
 
 		Schema: map[string]*schema.Schema{
-			"filter":       dataSourceFiltersSchema(),
+			"filter":       dataSourceFiltersSchemaFor(netPeeringFilters),
 			"accepter_net": vpcOAPIPeeringConnectionOptionsSchema(),
 			"net_peering_id": {
 				Type:     schema.TypeString,
@@ -59,13 +59,19 @@ func dataSourceOutscaleOAPILinPeeringConnectionRead(d *schema.ResourceData, meta
 	req := oscgo.ReadNetPeeringsRequest{}
 
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), netPeeringFilters); err != nil {
+		return err
+	}
 	if !filtersOk {
 		return fmt.Errorf("filters must be assigned")
 	}
-	req.SetFilters(buildOutscaleOAPILinPeeringConnectionFilters(filters.(*schema.Set)))
+	filtersReq, err := buildOutscaleOAPILinPeeringConnectionFilters(filters.(*schema.Set))
+	if err != nil {
+		return err
+	}
+	req.SetFilters(filtersReq)
 
 	var resp oscgo.ReadNetPeeringsResponse
-	var err error
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 		resp, _, err = conn.NetPeeringApi.ReadNetPeerings(context.Background()).ReadNetPeeringsRequest(req).Execute()
 
@@ -151,7 +157,23 @@ func dataSourceOutscaleOAPILinPeeringConnectionRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func buildOutscaleOAPILinPeeringConnectionFilters(set *schema.Set) oscgo.FiltersNetPeering {
+// netPeeringFilters are the filters supported by the Net peering data sources.
+var netPeeringFilters = dataSourceFilters{
+	"accepter_net_account_ids": filterString,
+	"accepter_net_ip_ranges":   filterString,
+	"accepter_net_net_ids":     filterString,
+	"net_peering_ids":          filterString,
+	"source_net_account_ids":   filterString,
+	"source_net_ip_ranges":     filterString,
+	"source_net_net_ids":       filterString,
+	"state_messages":           filterString,
+	"state_names":              filterString,
+	"tag_keys":                 filterString,
+	"tag_values":               filterString,
+	"tags":                     filterString,
+}
+
+func buildOutscaleOAPILinPeeringConnectionFilters(set *schema.Set) (oscgo.FiltersNetPeering, error) {
 	var filters oscgo.FiltersNetPeering
 	for _, v := range set.List() {
 		m := v.(map[string]interface{})
@@ -186,8 +208,8 @@ func buildOutscaleOAPILinPeeringConnectionFilters(set *schema.Set) oscgo.Filters
 		case "tags":
 			filters.SetTags(filterValues)
 		default:
-			log.Printf("[Debug] Unknown Filter Name: %s.", name)
+			return oscgo.FiltersNetPeering{}, fmt.Errorf("unknown filter name %q", name)
 		}
 	}
-	return filters
+	return filters, nil
 }
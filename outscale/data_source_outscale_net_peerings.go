@@ -17,7 +17,7 @@ func dataSourceOutscaleOAPILinPeeringsConnection() *schema.Resource {
 		Read: dataSourceOutscaleOAPILinPeeringsConnectionRead,
 
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter": dataSourceFiltersSchemaFor(netPeeringFilters),
 			"net_peerings": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -63,15 +63,21 @@ func dataSourceOutscaleOAPILinPeeringsConnectionRead(d *schema.ResourceData, met
 	log.Printf("[DEBUG] Reading VPC Peering Connections.")
 
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), netPeeringFilters); err != nil {
+		return err
+	}
 	if !filtersOk {
 		return fmt.Errorf("One of filters must be assigned")
 	}
 
 	params := oscgo.ReadNetPeeringsRequest{}
-	params.SetFilters(buildOutscaleOAPILinPeeringConnectionFilters(filters.(*schema.Set)))
+	filtersReq, err := buildOutscaleOAPILinPeeringConnectionFilters(filters.(*schema.Set))
+	if err != nil {
+		return err
+	}
+	params.SetFilters(filtersReq)
 
 	var resp oscgo.ReadNetPeeringsResponse
-	var err error
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 		resp, _, err = conn.NetPeeringApi.ReadNetPeerings(context.Background()).ReadNetPeeringsRequest(params).Execute()
 		return resource.RetryableError(err)
@@ -17,7 +17,7 @@ func dataSourceOutscaleOAPIVpcs() *schema.Resource {
 		Read: dataSourceOutscaleOAPIVpcsRead,
 
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter": dataSourceFiltersSchemaFor(netFilters),
 			"net_id": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -70,6 +70,9 @@ func dataSourceOutscaleOAPIVpcsRead(d *schema.ResourceData, meta interface{}) er
 	req := oscgo.ReadNetsRequest{}
 
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), netFilters); err != nil {
+		return err
+	}
 	netIds, netIdsOk := d.GetOk("net_id")
 
 	if !filtersOk && !netIdsOk {
@@ -77,7 +80,11 @@ func dataSourceOutscaleOAPIVpcsRead(d *schema.ResourceData, meta interface{}) er
 	}
 
 	if filtersOk {
-		req.SetFilters(buildOutscaleOAPIDataSourceNetFilters(filters.(*schema.Set)))
+		filtersReq, err := buildOutscaleOAPIDataSourceNetFilters(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
+		req.SetFilters(filtersReq)
 	}
 
 	if netIdsOk {
@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
 	"strings"
 	"time"
 
@@ -23,7 +22,7 @@ func dataSourceOutscaleOAPINic() *schema.Resource {
 		Read: dataSourceOutscaleOAPINicRead,
 
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter": dataSourceFiltersSchemaFor(nicFilters),
 			// This is attribute part for schema Nic
 			// Argument
 			"nic_id": {
@@ -220,6 +219,9 @@ func dataSourceOutscaleOAPINicRead(d *schema.ResourceData, meta interface{}) err
 
 	nicID, okID := d.GetOk("nic_id")
 	filters, okFilters := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), nicFilters); err != nil {
+		return err
+	}
 
 	if okID && okFilters {
 		return errors.New("nic_id and filter set")
@@ -234,7 +236,11 @@ func dataSourceOutscaleOAPINicRead(d *schema.ResourceData, meta interface{}) err
 	}
 
 	if okFilters {
-		dnri.SetFilters(buildOutscaleOAPIDataSourceNicFilters(filters.(*schema.Set)))
+		filtersReq, err := buildOutscaleOAPIDataSourceNicFilters(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
+		dnri.SetFilters(filtersReq)
 	}
 
 	var resp oscgo.ReadNicsResponse
@@ -383,7 +389,39 @@ func dataSourceOutscaleOAPINicRead(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func buildOutscaleOAPIDataSourceNicFilters(set *schema.Set) oscgo.FiltersNic {
+// nicFilters are the filters supported by the NIC data sources.
+var nicFilters = dataSourceFilters{
+	"descriptions":                           filterString,
+	"is_source_dest_check":                   filterBool,
+	"link_nic_delete_on_vm_deletion":         filterBool,
+	"link_nic_device_numbers":                filterInt,
+	"link_nic_link_nic_ids":                  filterString,
+	"link_nic_states":                        filterString,
+	"link_nic_vm_account_ids":                filterString,
+	"link_nic_vm_ids":                        filterString,
+	"link_public_ip_account_ids":             filterString,
+	"link_public_ip_link_public_ip_ids":      filterString,
+	"link_public_ip_public_ip_ids":           filterString,
+	"link_public_ip_public_ips":              filterString,
+	"mac_addresses":                          filterString,
+	"private_ips_primary_ip":                 filterBool,
+	"tag_keys":                               filterString,
+	"tag_values":                             filterString,
+	"tags":                                   filterString,
+	"net_ids":                                filterString,
+	"nic_ids":                                filterString,
+	"private_dns_names":                      filterString,
+	"private_ips_link_public_ip_account_ids": filterString,
+	"private_ips_link_public_ip_public_ips":  filterString,
+	"private_ips_private_ips":                filterString,
+	"security_group_ids":                     filterString,
+	"security_group_names":                   filterString,
+	"states":                                 filterString,
+	"subnet_ids":                             filterString,
+	"subregion_names":                        filterString,
+}
+
+func buildOutscaleOAPIDataSourceNicFilters(set *schema.Set) (oscgo.FiltersNic, error) {
 	var filters oscgo.FiltersNic
 	for _, v := range set.List() {
 		m := v.(map[string]interface{})
@@ -450,8 +488,8 @@ func buildOutscaleOAPIDataSourceNicFilters(set *schema.Set) oscgo.FiltersNic {
 		case "subregion_names":
 			filters.SetSubregionNames(filterValues)
 		default:
-			log.Printf("[Debug] Unknown Filter Name: %s.", name)
+			return oscgo.FiltersNic{}, fmt.Errorf("unknown filter name %q", name)
 		}
 	}
-	return filters
+	return filters, nil
 }
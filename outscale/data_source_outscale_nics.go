@@ -22,7 +22,7 @@ func dataSourceOutscaleOAPINics() *schema.Resource {
 func getDSOAPINicsSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		//  This is attribute part for schema Nic
-		"filter": dataSourceFiltersSchema(),
+		"filter": dataSourceFiltersSchemaFor(nicFilters),
 		"nics": {
 			Type:     schema.TypeList,
 			Computed: true,
@@ -225,13 +225,20 @@ func dataSourceOutscaleOAPINicsRead(d *schema.ResourceData, meta interface{}) er
 	conn := meta.(*OutscaleClient).OSCAPI
 
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), nicFilters); err != nil {
+		return err
+	}
 	if !filtersOk {
 		return fmt.Errorf("filters, or owner must be assigned, or nic_id must be provided")
 	}
 
 	params := oscgo.ReadNicsRequest{}
 	if filtersOk {
-		params.SetFilters(buildOutscaleOAPIDataSourceNicFilters(filters.(*schema.Set)))
+		filtersReq, err := buildOutscaleOAPIDataSourceNicFilters(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
+		params.SetFilters(filtersReq)
 	}
 
 	var resp oscgo.ReadNicsResponse
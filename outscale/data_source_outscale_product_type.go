@@ -3,7 +3,6 @@ package outscale
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 	"time"
 
@@ -18,7 +17,7 @@ func dataSourceOutscaleOAPIProductType() *schema.Resource {
 		Read: dataSourceOutscaleOAPIProductTypeRead,
 
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter": dataSourceFiltersSchemaFor(productTypeFilters),
 			"description": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -45,9 +44,16 @@ func dataSourceOutscaleOAPIProductTypeRead(d *schema.ResourceData, meta interfac
 	req := oscgo.ReadProductTypesRequest{}
 
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), productTypeFilters); err != nil {
+		return err
+	}
 
 	if filtersOk {
-		req.Filters = buildOutscaleOAPIProductTypeDataSourceFilters(filters.(*schema.Set))
+		var err error
+		req.Filters, err = buildOutscaleOAPIProductTypeDataSourceFilters(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
 	}
 
 	var resp oscgo.ReadProductTypesResponse
@@ -95,7 +101,12 @@ func dataSourceOutscaleOAPIProductTypeRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func buildOutscaleOAPIProductTypeDataSourceFilters(set *schema.Set) *oscgo.FiltersProductType {
+// productTypeFilters are the filters supported by the product type data sources.
+var productTypeFilters = dataSourceFilters{
+	"product_type_ids": filterString,
+}
+
+func buildOutscaleOAPIProductTypeDataSourceFilters(set *schema.Set) (*oscgo.FiltersProductType, error) {
 	var filters oscgo.FiltersProductType
 	for _, v := range set.List() {
 		m := v.(map[string]interface{})
@@ -108,8 +119,8 @@ func buildOutscaleOAPIProductTypeDataSourceFilters(set *schema.Set) *oscgo.Filte
 		case "product_type_ids":
 			filters.ProductTypeIds = &filterValues
 		default:
-			log.Printf("[Debug] Unknown Filter Name: %s.", name)
+			return nil, fmt.Errorf("unknown filter name %q", name)
 		}
 	}
-	return &filters
+	return &filters, nil
 }
@@ -17,7 +17,7 @@ func dataSourceOutscaleOAPIProductTypes() *schema.Resource {
 		Read: dataSourceOutscaleOAPIProductTypesRead,
 
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter": dataSourceFiltersSchemaFor(productTypeFilters),
 			"product_types": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -52,9 +52,16 @@ func dataSourceOutscaleOAPIProductTypesRead(d *schema.ResourceData, meta interfa
 	req := oscgo.ReadProductTypesRequest{}
 
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), productTypeFilters); err != nil {
+		return err
+	}
 
 	if filtersOk {
-		req.Filters = buildOutscaleOAPIProductTypeDataSourceFilters(filters.(*schema.Set))
+		var err error
+		req.Filters, err = buildOutscaleOAPIProductTypeDataSourceFilters(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
 	}
 
 	var resp oscgo.ReadProductTypesResponse
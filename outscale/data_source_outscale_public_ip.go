@@ -24,7 +24,7 @@ func dataSourceOutscaleOAPIPublicIP() *schema.Resource {
 func getOAPIPublicIPDataSourceSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		// Attributes
-		"filter": dataSourceFiltersSchema(),
+		"filter": dataSourceFiltersSchemaFor(publicIPFilters),
 		"public_ip_id": {
 			Type:     schema.TypeString,
 			Optional: true,
@@ -79,8 +79,15 @@ func dataSourceOutscaleOAPIPublicIPRead(d *schema.ResourceData, meta interface{}
 	}
 
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), publicIPFilters); err != nil {
+		return err
+	}
 	if filtersOk {
-		req.Filters = buildOutscaleOAPIDataSourcePublicIpsFilters(filters.(*schema.Set))
+		var err error
+		req.Filters, err = buildOutscaleOAPIDataSourcePublicIpsFilters(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
 	}
 
 	var response oscgo.ReadPublicIpsResponse
@@ -148,7 +155,22 @@ func dataSourceOutscaleOAPIPublicIPRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func buildOutscaleOAPIDataSourcePublicIpsFilters(set *schema.Set) *oscgo.FiltersPublicIp {
+// publicIPFilters are the filters supported by the public IP data sources.
+var publicIPFilters = dataSourceFilters{
+	"public_ip_ids":      filterString,
+	"link_public_ip_ids": filterString,
+	"placements":         filterString,
+	"vm_ids":             filterString,
+	"nic_ids":            filterString,
+	"nic_account_ids":    filterString,
+	"private_ips":        filterString,
+	"public_ips":         filterString,
+	"tag_keys":           filterString,
+	"tag_values":         filterString,
+	"tags":               filterString,
+}
+
+func buildOutscaleOAPIDataSourcePublicIpsFilters(set *schema.Set) (*oscgo.FiltersPublicIp, error) {
 	var filters oscgo.FiltersPublicIp
 	for _, v := range set.List() {
 		m := v.(map[string]interface{})
@@ -181,8 +203,8 @@ func buildOutscaleOAPIDataSourcePublicIpsFilters(set *schema.Set) *oscgo.Filters
 		case "tags":
 			filters.SetTags(filterValues)
 		default:
-			log.Printf("[Debug] Unknown Filter Name: %s.", name)
+			return nil, fmt.Errorf("unknown filter name %q", name)
 		}
 	}
-	return &filters
+	return &filters, nil
 }
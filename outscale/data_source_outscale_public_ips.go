@@ -22,7 +22,7 @@ func dataSourceOutscaleOAPIPublicIPS() *schema.Resource {
 func oapiGetPublicIPSDataSourceSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		// Attributes
-		"filter": dataSourceFiltersSchema(),
+		"filter": dataSourceFiltersSchemaFor(publicIPFilters),
 		"public_ips": {
 			Type:     schema.TypeList,
 			Computed: true,
@@ -73,9 +73,16 @@ func dataSourceOutscalePublicIPSRead(d *schema.ResourceData, meta interface{}) e
 	req := oscgo.ReadPublicIpsRequest{}
 
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), publicIPFilters); err != nil {
+		return err
+	}
 
 	if filtersOk {
-		req.Filters = buildOutscaleOAPIDataSourcePublicIpsFilters(filters.(*schema.Set))
+		var err error
+		req.Filters, err = buildOutscaleOAPIDataSourcePublicIpsFilters(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
 	}
 
 	var resp oscgo.ReadPublicIpsResponse
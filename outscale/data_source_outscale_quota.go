@@ -3,7 +3,6 @@ package outscale
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 	"time"
 
@@ -18,7 +17,7 @@ func dataSourceOutscaleOAPIQuota() *schema.Resource {
 		Read: dataSourceOutscaleOAPIQuotaRead,
 
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter": dataSourceFiltersSchemaFor(quotaFilters),
 			"name": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -65,9 +64,16 @@ func dataSourceOutscaleOAPIQuotaRead(d *schema.ResourceData, meta interface{}) e
 	req := oscgo.ReadQuotasRequest{}
 
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), quotaFilters); err != nil {
+		return err
+	}
 
 	if filtersOk {
-		req.Filters = buildOutscaleOAPIQuotaDataSourceFilters(filters.(*schema.Set))
+		var err error
+		req.Filters, err = buildOutscaleOAPIQuotaDataSourceFilters(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
 	}
 
 	var resp oscgo.ReadQuotasResponse
@@ -140,7 +146,15 @@ func dataSourceOutscaleOAPIQuotaRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func buildOutscaleOAPIQuotaDataSourceFilters(set *schema.Set) *oscgo.FiltersQuota {
+// quotaFilters are the filters supported by the quota data sources.
+var quotaFilters = dataSourceFilters{
+	"quota_types":        filterString,
+	"quota_names":        filterString,
+	"collections":        filterString,
+	"short_descriptions": filterString,
+}
+
+func buildOutscaleOAPIQuotaDataSourceFilters(set *schema.Set) (*oscgo.FiltersQuota, error) {
 	var filters oscgo.FiltersQuota
 	for _, v := range set.List() {
 		m := v.(map[string]interface{})
@@ -159,8 +173,8 @@ func buildOutscaleOAPIQuotaDataSourceFilters(set *schema.Set) *oscgo.FiltersQuot
 		case "short_descriptions":
 			filters.ShortDescriptions = &filterValues
 		default:
-			log.Printf("[Debug] Unknown Filter Name: %s.", name)
+			return nil, fmt.Errorf("unknown filter name %q", name)
 		}
 	}
-	return &filters
+	return &filters, nil
 }
@@ -17,7 +17,7 @@ func dataSourceOutscaleOAPIQuotas() *schema.Resource {
 		Read: dataSourceOutscaleOAPIQuotasRead,
 
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter": dataSourceFiltersSchemaFor(quotaFilters),
 			"quotas": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -72,9 +72,16 @@ func dataSourceOutscaleOAPIQuotasRead(d *schema.ResourceData, meta interface{})
 	req := oscgo.ReadQuotasRequest{}
 
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), quotaFilters); err != nil {
+		return err
+	}
 
 	if filtersOk {
-		req.Filters = buildOutscaleOAPIQuotaDataSourceFilters(filters.(*schema.Set))
+		var err error
+		req.Filters, err = buildOutscaleOAPIQuotaDataSourceFilters(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
 	}
 
 	var resp oscgo.ReadQuotasResponse
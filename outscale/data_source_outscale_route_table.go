@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"github.com/spf13/cast"
-	"log"
 	"strings"
 	"time"
 
@@ -19,7 +18,7 @@ func dataSourceOutscaleOAPIRouteTable() *schema.Resource {
 		Read: dataSourceOutscaleOAPIRouteTableRead,
 
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter": dataSourceFiltersSchemaFor(routeTableFilters),
 			"route_table_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -134,6 +133,9 @@ func dataSourceOutscaleOAPIRouteTableRead(d *schema.ResourceData, meta interface
 	conn := meta.(*OutscaleClient).OSCAPI
 	routeTableID, routeTableIDOk := d.GetOk("route_table_id")
 	filter, filterOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filter.(*schema.Set), routeTableFilters); err != nil {
+		return err
+	}
 
 	if !filterOk && !routeTableIDOk {
 		return fmt.Errorf("One of route_table_id or filters must be assigned")
@@ -147,7 +149,11 @@ func dataSourceOutscaleOAPIRouteTableRead(d *schema.ResourceData, meta interface
 	}
 
 	if filterOk {
-		params.Filters = buildOutscaleOAPIDataSourceRouteTableFilters(filter.(*schema.Set))
+		var err error
+		params.Filters, err = buildOutscaleOAPIDataSourceRouteTableFilters(filter.(*schema.Set))
+		if err != nil {
+			return err
+		}
 	}
 
 	var resp oscgo.ReadRouteTablesResponse
@@ -191,7 +197,28 @@ func dataSourceOutscaleOAPIRouteTableRead(d *schema.ResourceData, meta interface
 	return d.Set("link_route_tables", setOSCAPILinkRouteTables(rt.GetLinkRouteTables()))
 }
 
-func buildOutscaleOAPIDataSourceRouteTableFilters(set *schema.Set) *oscgo.FiltersRouteTable {
+// routeTableFilters are the filters supported by the route table data sources.
+var routeTableFilters = dataSourceFilters{
+	"route_table_ids":                       filterString,
+	"link_route_table_link_route_table_ids": filterString,
+	"tag_keys":                              filterString,
+	"tag_values":                            filterString,
+	"tags":                                  filterString,
+	"link_route_table_ids":                  filterString,
+	"link_route_table_main":                 filterBool,
+	"link_subnet_ids":                       filterString,
+	"net_ids":                               filterString,
+	"route_creation_methods":                filterString,
+	"route_destination_ip_ranges":           filterString,
+	"route_destination_service_ids":         filterString,
+	"route_gateway_ids":                     filterString,
+	"route_nat_service_ids":                 filterString,
+	"route_net_peering_ids":                 filterString,
+	"route_states":                          filterString,
+	"route_vm_ids":                          filterString,
+}
+
+func buildOutscaleOAPIDataSourceRouteTableFilters(set *schema.Set) (*oscgo.FiltersRouteTable, error) {
 	var filters oscgo.FiltersRouteTable
 	for _, v := range set.List() {
 		m := v.(map[string]interface{})
@@ -235,8 +262,8 @@ func buildOutscaleOAPIDataSourceRouteTableFilters(set *schema.Set) *oscgo.Filter
 		case "route_vm_ids":
 			filters.SetRouteVmIds(filterValues)
 		default:
-			log.Printf("[Debug] Unknown Filter Name: %s.", name)
+			return nil, fmt.Errorf("unknown filter name %q", name)
 		}
 	}
-	return &filters
+	return &filters, nil
 }
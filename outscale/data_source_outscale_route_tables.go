@@ -17,7 +17,7 @@ func dataSourceOutscaleOAPIRouteTables() *schema.Resource {
 		Read: dataSourceOutscaleOAPIRouteTablesRead,
 
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter": dataSourceFiltersSchemaFor(routeTableFilters),
 			"route_table_id": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -144,6 +144,9 @@ func dataSourceOutscaleOAPIRouteTablesRead(d *schema.ResourceData, meta interfac
 	conn := meta.(*OutscaleClient).OSCAPI
 	rtbID, rtbOk := d.GetOk("route_table_id")
 	filter, filterOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filter.(*schema.Set), routeTableFilters); err != nil {
+		return err
+	}
 	if !filterOk && !rtbOk {
 		return fmt.Errorf("One of route_table_id or filters must be assigned")
 	}
@@ -164,7 +167,11 @@ func dataSourceOutscaleOAPIRouteTablesRead(d *schema.ResourceData, meta interfac
 	}
 
 	if filterOk {
-		params.Filters = buildOutscaleOAPIDataSourceRouteTableFilters(filter.(*schema.Set))
+		var err error
+		params.Filters, err = buildOutscaleOAPIDataSourceRouteTableFilters(filter.(*schema.Set))
+		if err != nil {
+			return err
+		}
 	}
 
 	var resp oscgo.ReadRouteTablesResponse
@@ -3,7 +3,6 @@ package outscale
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 	"time"
 
@@ -19,7 +18,7 @@ func dataSourceOutscaleOAPISecurityGroup() *schema.Resource {
 		Read: dataSourceOutscaleOAPISecurityGroupRead,
 
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter": dataSourceFiltersSchemaFor(securityGroupFilters),
 			"security_group_name": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -162,6 +161,9 @@ func dataSourceOutscaleOAPISecurityGroupRead(d *schema.ResourceData, meta interf
 	req := oscgo.ReadSecurityGroupsRequest{}
 
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), securityGroupFilters); err != nil {
+		return err
+	}
 	gn, gnOk := d.GetOk("security_group_name")
 	gid, gidOk := d.GetOk("security_group_id")
 
@@ -177,7 +179,11 @@ func dataSourceOutscaleOAPISecurityGroupRead(d *schema.ResourceData, meta interf
 	}
 
 	if filtersOk {
-		req.SetFilters(buildOutscaleOAPIDataSourceSecurityGroupFilters(filters.(*schema.Set)))
+		filtersReq, err := buildOutscaleOAPIDataSourceSecurityGroupFilters(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
+		req.SetFilters(filtersReq)
 	}
 
 	var err error
@@ -245,7 +251,33 @@ func dataSourceOutscaleOAPISecurityGroupRead(d *schema.ResourceData, meta interf
 	return d.Set("outbound_rules", flattenOAPISecurityGroupRule(sg.GetOutboundRules()))
 }
 
-func buildOutscaleOAPIDataSourceSecurityGroupFilters(set *schema.Set) oscgo.FiltersSecurityGroup {
+// securityGroupFilters are the filters supported by the security group data sources.
+var securityGroupFilters = dataSourceFilters{
+	"account_ids":                        filterString,
+	"descriptions":                       filterString,
+	"inbound_rule_account_ids":           filterString,
+	"inbound_rule_from_port_ranges":      filterInt,
+	"inbound_rule_ip_ranges":             filterString,
+	"inbound_rule_protocols":             filterString,
+	"inbound_rule_security_group_ids":    filterString,
+	"inbound_rule_security_group_names":  filterString,
+	"inbound_rule_to_port_ranges":        filterInt,
+	"net_ids":                            filterString,
+	"outbound_rule_account_ids":          filterString,
+	"outbound_rule_from_port_ranges":     filterInt,
+	"outbound_rule_ip_ranges":            filterString,
+	"outbound_rule_protocols":            filterString,
+	"outbound_rule_security_group_ids":   filterString,
+	"outbound_rule_security_group_names": filterString,
+	"outbound_rule_to_port_ranges":       filterInt,
+	"security_group_ids":                 filterString,
+	"security_group_names":               filterString,
+	"tag_keys":                           filterString,
+	"tag_values":                         filterString,
+	"tags":                               filterString,
+}
+
+func buildOutscaleOAPIDataSourceSecurityGroupFilters(set *schema.Set) (oscgo.FiltersSecurityGroup, error) {
 	var filters oscgo.FiltersSecurityGroup
 	for _, v := range set.List() {
 		m := v.(map[string]interface{})
@@ -300,8 +332,8 @@ func buildOutscaleOAPIDataSourceSecurityGroupFilters(set *schema.Set) oscgo.Filt
 		case "tags":
 			filters.SetTags(filterValues)
 		default:
-			log.Printf("[Debug] Unknown Filter Name: %s.", name)
+			return oscgo.FiltersSecurityGroup{}, fmt.Errorf("unknown filter name %q", name)
 		}
 	}
-	return filters
+	return filters, nil
 }
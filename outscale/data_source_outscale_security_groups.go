@@ -19,7 +19,7 @@ func dataSourceOutscaleOAPISecurityGroups() *schema.Resource {
 		Read: dataSourceOutscaleOAPISecurityGroupsRead,
 
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter": dataSourceFiltersSchemaFor(securityGroupFilters),
 			"security_group_names": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -183,6 +183,9 @@ func dataSourceOutscaleOAPISecurityGroupsRead(d *schema.ResourceData, meta inter
 	req := oscgo.ReadSecurityGroupsRequest{}
 
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), securityGroupFilters); err != nil {
+		return err
+	}
 	gn, gnOk := d.GetOk("security_group_names")
 	gid, gidOk := d.GetOk("security_group_ids")
 	var filter oscgo.FiltersSecurityGroup
@@ -205,7 +208,11 @@ func dataSourceOutscaleOAPISecurityGroupsRead(d *schema.ResourceData, meta inter
 	}
 
 	if filtersOk {
-		req.SetFilters(buildOutscaleOAPIDataSourceSecurityGroupFilters(filters.(*schema.Set)))
+		filtersReq, err := buildOutscaleOAPIDataSourceSecurityGroupFilters(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
+		req.SetFilters(filtersReq)
 	}
 
 	var err error
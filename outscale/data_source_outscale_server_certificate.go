@@ -18,7 +18,7 @@ func datasourceOutscaleOAPIServerCertificate() *schema.Resource {
 	return &schema.Resource{
 		Read: datasourceOutscaleOAPIServerCertificateRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter": dataSourceFiltersSchemaFor(serverCertificateFilters),
 			"expiration_date": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -47,6 +47,9 @@ func datasourceOutscaleOAPIServerCertificateRead(d *schema.ResourceData, meta in
 	conn := meta.(*OutscaleClient).OSCAPI
 
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), serverCertificateFilters); err != nil {
+		return err
+	}
 
 	if !filtersOk {
 		return fmt.Errorf("filters must be assigned")
@@ -56,7 +59,11 @@ func datasourceOutscaleOAPIServerCertificateRead(d *schema.ResourceData, meta in
 	params := oscgo.ReadServerCertificatesRequest{}
 
 	if filtersOk {
-		params.Filters = buildOutscaleOSCAPIDataSourceServerCertificateFilters(filters.(*schema.Set))
+		var err error
+		params.Filters, err = buildOutscaleOSCAPIDataSourceServerCertificateFilters(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
 	}
 
 	var resp oscgo.ReadServerCertificatesResponse
@@ -101,7 +108,12 @@ func datasourceOutscaleOAPIServerCertificateRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func buildOutscaleOSCAPIDataSourceServerCertificateFilters(set *schema.Set) *oscgo.FiltersServerCertificate {
+// serverCertificateFilters are the filters supported by the server certificate data sources.
+var serverCertificateFilters = dataSourceFilters{
+	"paths": filterString,
+}
+
+func buildOutscaleOSCAPIDataSourceServerCertificateFilters(set *schema.Set) (*oscgo.FiltersServerCertificate, error) {
 	var filters oscgo.FiltersServerCertificate
 	for _, v := range set.List() {
 		m := v.(map[string]interface{})
@@ -114,8 +126,8 @@ func buildOutscaleOSCAPIDataSourceServerCertificateFilters(set *schema.Set) *osc
 		case "paths":
 			filters.SetPaths(filterValues)
 		default:
-			log.Printf("[Debug] Unknown Filter Name: %s.", name)
+			return nil, fmt.Errorf("unknown filter name %q", name)
 		}
 	}
-	return &filters
+	return &filters, nil
 }
@@ -16,7 +16,7 @@ func datasourceOutscaleOAPIServerCertificates() *schema.Resource {
 	return &schema.Resource{
 		Read: datasourceOutscaleOAPIServerCertificatesRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter": dataSourceFiltersSchemaFor(serverCertificateFilters),
 			"server_certificates": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -57,12 +57,19 @@ func datasourceOutscaleOAPIServerCertificatesRead(d *schema.ResourceData, meta i
 	conn := meta.(*OutscaleClient).OSCAPI
 
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), serverCertificateFilters); err != nil {
+		return err
+	}
 
 	// Build up search parameters
 	params := oscgo.ReadServerCertificatesRequest{}
 
 	if filtersOk {
-		params.Filters = buildOutscaleOSCAPIDataSourceServerCertificateFilters(filters.(*schema.Set))
+		var err error
+		params.Filters, err = buildOutscaleOSCAPIDataSourceServerCertificateFilters(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
 	}
 
 	var resp oscgo.ReadServerCertificatesResponse
@@ -3,7 +3,6 @@ package outscale
 import (
 	"context"
 	"fmt"
-	"log"
 	"strconv"
 	"strings"
 	"time"
@@ -22,7 +21,7 @@ func dataSourceOutscaleOAPISnapshot() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			//selection criteria
-			"filter": dataSourceFiltersSchema(),
+			"filter": dataSourceFiltersSchemaFor(snapshotFilters),
 			"permissions_to_create_volume": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -41,7 +40,17 @@ func dataSourceOutscaleOAPISnapshot() *schema.Resource {
 				},
 			},
 
+			"most_recent": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			//Computed values returned
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"progress": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -90,6 +99,9 @@ func dataSourceOutscaleOAPISnapshotRead(d *schema.ResourceData, meta interface{}
 
 	restorableUsers, restorableUsersOk := d.GetOk("permission_to_create_volume")
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), snapshotFilters); err != nil {
+		return err
+	}
 	snapshotIds, snapshotIdsOk := d.GetOk("snapshot_id")
 	owners, ownersOk := d.GetOk("account_id")
 
@@ -107,7 +119,9 @@ func dataSourceOutscaleOAPISnapshotRead(d *schema.ResourceData, meta interface{}
 		params.SetFilters(filter)
 	}
 	if filtersOk {
-		buildOutscaleOapiSnapshootDataSourceFilters(filters.(*schema.Set), params.Filters)
+		if _, err := buildOutscaleOapiSnapshootDataSourceFilters(filters.(*schema.Set), params.Filters); err != nil {
+			return err
+		}
 	}
 	if ownersOk {
 		filter.SetAccountIds([]string{owners.(string)})
@@ -139,11 +153,15 @@ func dataSourceOutscaleOAPISnapshotRead(d *schema.ResourceData, meta interface{}
 	if len(resp.GetSnapshots()) < 1 {
 		return fmt.Errorf("your query returned no results, please change your search criteria and try again")
 	}
-	if len(resp.GetSnapshots()) > 1 {
-		return fmt.Errorf("your query returned more than one result, please try a more specific search criteria")
+	snapshots := resp.GetSnapshots()
+	if len(snapshots) > 1 {
+		if !d.Get("most_recent").(bool) {
+			return fmt.Errorf("your query returned more than one result, please try a more specific search criteria, or set most_recent to true")
+		}
+		sortOAPISnapshots(snapshots, "creation_date", "desc")
 	}
 
-	snapshot := resp.GetSnapshots()[0]
+	snapshot := snapshots[0]
 
 	//Single Snapshot found so set to state
 	return snapshotOAPIDescriptionAttributes(d, &snapshot)
@@ -151,6 +169,9 @@ func dataSourceOutscaleOAPISnapshotRead(d *schema.ResourceData, meta interface{}
 
 func snapshotOAPIDescriptionAttributes(d *schema.ResourceData, snapshot *oscgo.Snapshot) error {
 	d.SetId(snapshot.GetSnapshotId())
+	if err := d.Set("creation_date", snapshot.GetCreationDate()); err != nil {
+		return err
+	}
 	if err := d.Set("description", snapshot.GetDescription()); err != nil {
 		return err
 	}
@@ -188,7 +209,24 @@ func snapshotOAPIDescriptionAttributes(d *schema.ResourceData, snapshot *oscgo.S
 	return d.Set("tags", tagsOSCAPIToMap(snapshot.GetTags()))
 }
 
-func buildOutscaleOapiSnapshootDataSourceFilters(set *schema.Set, filter *oscgo.FiltersSnapshot) *oscgo.FiltersSnapshot {
+// snapshotFilters are the filters supported by the snapshot data sources.
+var snapshotFilters = dataSourceFilters{
+	"account_aliases": filterString,
+	"account_ids":     filterString,
+	"descriptions":    filterString,
+	"permissions_to_create_volume_account_ids":       filterString,
+	"permissions_to_create_volume_global_permission": filterBool,
+	"progresses":   filterInt,
+	"snapshot_ids": filterString,
+	"states":       filterString,
+	"tag_keys":     filterString,
+	"tag_values":   filterString,
+	"tags":         filterString,
+	"volume_ids":   filterString,
+	"volume_sizes": filterInt,
+}
+
+func buildOutscaleOapiSnapshootDataSourceFilters(set *schema.Set, filter *oscgo.FiltersSnapshot) (*oscgo.FiltersSnapshot, error) {
 
 	for _, v := range set.List() {
 		m := v.(map[string]interface{})
@@ -240,10 +278,10 @@ func buildOutscaleOapiSnapshootDataSourceFilters(set *schema.Set, filter *oscgo.
 			filter.SetVolumeSizes(utils.StringSliceToInt32Slice(values))
 
 		default:
-			log.Printf("[Debug] Unknown Filter Name: %s.", name)
+			return nil, fmt.Errorf("unknown filter name %q", name)
 		}
 	}
-	return filter
+	return filter, nil
 }
 
 func oapiExpandStringList(configured []interface{}) []string {
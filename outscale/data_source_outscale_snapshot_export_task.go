@@ -3,7 +3,6 @@ package outscale
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 	"time"
 
@@ -25,7 +24,7 @@ func dataSourceOutscaleOAPISnapshotExportTask() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter": dataSourceFiltersSchemaFor(snapshotExportTaskFilters),
 			"dry_run": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -84,10 +83,17 @@ func dataSourceOAPISnapshotExportTaskRead(d *schema.ResourceData, meta interface
 	conn := meta.(*OutscaleClient).OSCAPI
 
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), snapshotExportTaskFilters); err != nil {
+		return err
+	}
 
 	filtersReq := &oscgo.FiltersExportTask{}
 	if filtersOk {
-		filtersReq = buildOutscaleOSCAPIDataSourceSnapshotExportTaskFilters(filters.(*schema.Set))
+		var err error
+		filtersReq, err = buildOutscaleOSCAPIDataSourceSnapshotExportTaskFilters(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
 	}
 
 	var resp oscgo.ReadSnapshotExportTasksResponse
@@ -151,7 +157,12 @@ func dataSourceOAPISnapshotExportTaskRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func buildOutscaleOSCAPIDataSourceSnapshotExportTaskFilters(set *schema.Set) *oscgo.FiltersExportTask {
+// snapshotExportTaskFilters are the filters supported by the snapshot export task data sources.
+var snapshotExportTaskFilters = dataSourceFilters{
+	"task_ids": filterString,
+}
+
+func buildOutscaleOSCAPIDataSourceSnapshotExportTaskFilters(set *schema.Set) (*oscgo.FiltersExportTask, error) {
 	var filters oscgo.FiltersExportTask
 	for _, v := range set.List() {
 		m := v.(map[string]interface{})
@@ -164,8 +175,8 @@ func buildOutscaleOSCAPIDataSourceSnapshotExportTaskFilters(set *schema.Set) *os
 		case "task_ids":
 			filters.TaskIds = &filterValues
 		default:
-			log.Printf("[Debug] Unknown Filter Name: %s.", name)
+			return nil, fmt.Errorf("unknown filter name %q", name)
 		}
 	}
-	return &filters
+	return &filters, nil
 }
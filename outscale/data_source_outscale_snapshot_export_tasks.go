@@ -25,7 +25,7 @@ func dataSourceOutscaleOAPISnapshotExportTasks() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter": dataSourceFiltersSchemaFor(snapshotExportTaskFilters),
 			"dry_run": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -92,10 +92,17 @@ func dataSourceOAPISnapshotExportTasksRead(d *schema.ResourceData, meta interfac
 	conn := meta.(*OutscaleClient).OSCAPI
 
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), snapshotExportTaskFilters); err != nil {
+		return err
+	}
 
 	filtersReq := &oscgo.FiltersExportTask{}
 	if filtersOk {
-		filtersReq = buildOutscaleOSCAPIDataSourceSnapshotExportTaskFilters(filters.(*schema.Set))
+		var err error
+		filtersReq, err = buildOutscaleOSCAPIDataSourceSnapshotExportTaskFilters(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
 	}
 
 	var resp oscgo.ReadSnapshotExportTasksResponse
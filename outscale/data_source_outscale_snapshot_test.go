@@ -45,6 +45,25 @@ func TestAccOutscaleOAPISnapshotDataSource_multipleFilters(t *testing.T) {
 	})
 }
 
+func TestAccOutscaleOAPISnapshotDataSource_mostRecent(t *testing.T) {
+	region := os.Getenv("OUTSCALE_REGION")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckOutscaleOAPISnapshotDataSourceConfigMostRecent(region),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.outscale_snapshot.snapshot", "snapshot_id",
+						"outscale_snapshot.second", "id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckOutscaleOAPISnapshotDataSourceID(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -97,3 +116,32 @@ func testAccCheckOutscaleOAPISnapshotDataSourceConfigWithMultipleFilters(region
 		}
 	`, region)
 }
+
+func testAccCheckOutscaleOAPISnapshotDataSourceConfigMostRecent(region string) string {
+	return fmt.Sprintf(`
+		resource "outscale_volume" "example" {
+			subregion_name = "%sa"
+			size           = 1
+		}
+
+		resource "outscale_snapshot" "first" {
+			volume_id = "${outscale_volume.example.id}"
+		}
+
+		resource "outscale_snapshot" "second" {
+			volume_id  = "${outscale_volume.example.id}"
+			depends_on = ["outscale_snapshot.first"]
+		}
+
+		data "outscale_snapshot" "snapshot" {
+			most_recent = true
+
+			filter {
+				name   = "volume_ids"
+				values = ["${outscale_volume.example.id}"]
+			}
+
+			depends_on = ["outscale_snapshot.second"]
+		}
+	`, region)
+}
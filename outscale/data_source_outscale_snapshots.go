@@ -3,6 +3,7 @@ package outscale
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 )
 
 func dataSourceOutscaleOAPISnapshots() *schema.Resource {
@@ -18,7 +20,7 @@ func dataSourceOutscaleOAPISnapshots() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			//selection criteria
-			"filter": dataSourceFiltersSchema(),
+			"filter": dataSourceFiltersSchemaFor(snapshotFilters),
 			"account_id": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -34,12 +36,27 @@ func dataSourceOutscaleOAPISnapshots() *schema.Resource {
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"sort_by": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(snapshotSortKeys(), false),
+			},
+			"sort_order": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "asc",
+				ValidateFunc: validation.StringInSlice([]string{"asc", "desc"}, false),
+			},
 			//Computed values returned
 			"snapshots": {
 				Type:     schema.TypeList,
 				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"creation_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						"progress": {
 							Type:     schema.TypeInt,
 							Computed: true,
@@ -106,6 +123,9 @@ func dataSourceOutscaleOAPISnapshotsRead(d *schema.ResourceData, meta interface{
 
 	restorableUsers, restorableUsersOk := d.GetOk("permission_to_create_volume")
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), snapshotFilters); err != nil {
+		return err
+	}
 	snapshotIds, snapshotIdsOk := d.GetOk("snapshot_id")
 	owners, ownersOk := d.GetOk("account_id")
 
@@ -122,7 +142,9 @@ func dataSourceOutscaleOAPISnapshotsRead(d *schema.ResourceData, meta interface{
 		params.SetFilters(filter)
 	}
 	if filtersOk {
-		buildOutscaleOapiSnapshootDataSourceFilters(filters.(*schema.Set), params.Filters)
+		if _, err := buildOutscaleOapiSnapshootDataSourceFilters(filters.(*schema.Set), params.Filters); err != nil {
+			return err
+		}
 	}
 	if ownersOk {
 		filter.SetAccountIds(oapiExpandStringList(owners.([]interface{})))
@@ -155,10 +177,16 @@ func dataSourceOutscaleOAPISnapshotsRead(d *schema.ResourceData, meta interface{
 		return fmt.Errorf("your query returned no results, please change your search criteria and try again")
 	}
 
-	snapshots := make([]map[string]interface{}, len(resp.GetSnapshots()))
-	for k, v := range resp.GetSnapshots() {
+	result := resp.GetSnapshots()
+	if sortBy, ok := d.GetOk("sort_by"); ok {
+		sortOAPISnapshots(result, sortBy.(string), d.Get("sort_order").(string))
+	}
+
+	snapshots := make([]map[string]interface{}, len(result))
+	for k, v := range result {
 		snapshot := make(map[string]interface{})
 
+		snapshot["creation_date"] = v.GetCreationDate()
 		snapshot["description"] = v.GetDescription()
 		snapshot["account_alias"] = v.GetAccountAlias()
 		snapshot["account_id"] = v.GetAccountId()
@@ -183,3 +211,45 @@ func dataSourceOutscaleOAPISnapshotsRead(d *schema.ResourceData, meta interface{
 	//Single Snapshot found so set to state
 	return d.Set("snapshots", snapshots)
 }
+
+// snapshotComparators compare two snapshots on the attribute named by the key, they are used
+// to sort the snapshots of the data sources.
+var snapshotComparators = map[string]func(a, b oscgo.Snapshot) int{
+	"creation_date": func(a, b oscgo.Snapshot) int {
+		return strings.Compare(a.GetCreationDate(), b.GetCreationDate())
+	},
+	"snapshot_id": func(a, b oscgo.Snapshot) int {
+		return strings.Compare(a.GetSnapshotId(), b.GetSnapshotId())
+	},
+	"volume_id": func(a, b oscgo.Snapshot) int {
+		return strings.Compare(a.GetVolumeId(), b.GetVolumeId())
+	},
+	"volume_size": func(a, b oscgo.Snapshot) int {
+		return int(a.GetVolumeSize()) - int(b.GetVolumeSize())
+	},
+}
+
+func snapshotSortKeys() []string {
+	keys := make([]string, 0, len(snapshotComparators))
+	for key := range snapshotComparators {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// sortOAPISnapshots sorts the snapshots in place by sortBy, the snapshot ID breaks ties so the
+// order stays the same across reads.
+func sortOAPISnapshots(snapshots []oscgo.Snapshot, sortBy, sortOrder string) {
+	compare := snapshotComparators[sortBy]
+	sort.Slice(snapshots, func(i, j int) bool {
+		c := compare(snapshots[i], snapshots[j])
+		if c == 0 {
+			c = strings.Compare(snapshots[i].GetSnapshotId(), snapshots[j].GetSnapshotId())
+		}
+		if sortOrder == "desc" {
+			return c > 0
+		}
+		return c < 0
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	oscgo "github.com/outscale/osc-sdk-go/v2"
 )
 
 func TestAccOutscaleOAPISnapshotsDataSource_basic(t *testing.T) {
@@ -21,6 +22,33 @@ func TestAccOutscaleOAPISnapshotsDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestSortOAPISnapshots(t *testing.T) {
+	snapshots := []oscgo.Snapshot{
+		{SnapshotId: oscgo.PtrString("snap-3"), VolumeSize: oscgo.PtrInt32(10), CreationDate: oscgo.PtrString("2021-03-01T10:00:00.000Z")},
+		{SnapshotId: oscgo.PtrString("snap-1"), VolumeSize: oscgo.PtrInt32(10), CreationDate: oscgo.PtrString("2021-01-01T10:00:00.000Z")},
+		{SnapshotId: oscgo.PtrString("snap-2"), VolumeSize: oscgo.PtrInt32(5), CreationDate: oscgo.PtrString("2021-02-01T10:00:00.000Z")},
+	}
+
+	cases := []struct {
+		sortBy, sortOrder string
+		expected          []string
+	}{
+		{"creation_date", "asc", []string{"snap-1", "snap-2", "snap-3"}},
+		{"creation_date", "desc", []string{"snap-3", "snap-2", "snap-1"}},
+		{"volume_size", "asc", []string{"snap-2", "snap-1", "snap-3"}},
+		{"volume_size", "desc", []string{"snap-3", "snap-1", "snap-2"}},
+	}
+
+	for _, tc := range cases {
+		sortOAPISnapshots(snapshots, tc.sortBy, tc.sortOrder)
+		for i, snapshot := range snapshots {
+			if snapshot.GetSnapshotId() != tc.expected[i] {
+				t.Fatalf("sort by %s %s: expected %v, got %s at position %d", tc.sortBy, tc.sortOrder, tc.expected, snapshot.GetSnapshotId(), i)
+			}
+		}
+	}
+}
+
 const testAccCheckOutscaleOAPISnapshotsDataSourceConfig = `
 	resource "outscale_volume" "example" {
 		subregion_name = "eu-west-2a"
@@ -3,7 +3,6 @@ package outscale
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 	"time"
 
@@ -21,7 +20,7 @@ func dataSourceOutscaleOAPISubnet() *schema.Resource {
 		Read: dataSourceOutscaleOAPISubnetRead,
 
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter": dataSourceFiltersSchemaFor(subnetFilters),
 			"subregion_name": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -70,9 +69,16 @@ func dataSourceOutscaleOAPISubnetRead(d *schema.ResourceData, meta interface{})
 	}
 
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), subnetFilters); err != nil {
+		return err
+	}
 
 	if filtersOk {
-		req.Filters = buildOutscaleOAPISubnetDataSourceFilters(filters.(*schema.Set))
+		var err error
+		req.Filters, err = buildOutscaleOAPISubnetDataSourceFilters(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
 	}
 
 	var resp oscgo.ReadSubnetsResponse
@@ -135,7 +141,20 @@ func dataSourceOutscaleOAPISubnetRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func buildOutscaleOAPISubnetDataSourceFilters(set *schema.Set) *oscgo.FiltersSubnet {
+// subnetFilters are the filters supported by the subnet data sources.
+var subnetFilters = dataSourceFilters{
+	"available_ips_counts": filterInt,
+	"ip_ranges":            filterString,
+	"net_ids":              filterString,
+	"states":               filterString,
+	"subnet_ids":           filterString,
+	"subregion_names":      filterString,
+	"tag_keys":             filterString,
+	"tag_values":           filterString,
+	"tags":                 filterString,
+}
+
+func buildOutscaleOAPISubnetDataSourceFilters(set *schema.Set) (*oscgo.FiltersSubnet, error) {
 	var filters oscgo.FiltersSubnet
 	for _, v := range set.List() {
 		m := v.(map[string]interface{})
@@ -167,8 +186,8 @@ func buildOutscaleOAPISubnetDataSourceFilters(set *schema.Set) *oscgo.FiltersSub
 			filters.SetTags(filterValues)
 
 		default:
-			log.Printf("[Debug] Unknown Filter Name: %s.", name)
+			return nil, fmt.Errorf("unknown filter name %q", name)
 		}
 	}
-	return &filters
+	return &filters, nil
 }
@@ -2,7 +2,7 @@ package outscale
 
 import (
 	"context"
-	"log"
+	"fmt"
 	"strings"
 	"time"
 
@@ -18,7 +18,7 @@ func dataSourceOutscaleOAPISubregions() *schema.Resource {
 		Read: dataSourceOutscaleOAPISubregionsRead,
 
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter": dataSourceFiltersSchemaFor(subregionFilters),
 			// Computed values.
 			"request_id": {
 				Type:     schema.TypeString,
@@ -52,10 +52,17 @@ func dataSourceOutscaleOAPISubregionsRead(d *schema.ResourceData, meta interface
 	conn := meta.(*OutscaleClient).OSCAPI
 
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), subregionFilters); err != nil {
+		return err
+	}
 
 	filtersReq := &oscgo.FiltersSubregion{}
 	if filtersOk {
-		filtersReq = buildOutscaleOAPIDataSourceSubregionsFilters(filters.(*schema.Set))
+		var err error
+		filtersReq, err = buildOutscaleOAPIDataSourceSubregionsFilters(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
 	}
 
 	req := oscgo.ReadSubregionsRequest{Filters: filtersReq}
@@ -95,7 +102,12 @@ func dataSourceOutscaleOAPISubregionsRead(d *schema.ResourceData, meta interface
 	})
 }
 
-func buildOutscaleOAPIDataSourceSubregionsFilters(set *schema.Set) *oscgo.FiltersSubregion {
+// subregionFilters are the filters supported by the subregion data sources.
+var subregionFilters = dataSourceFilters{
+	"subregion_names": filterString,
+}
+
+func buildOutscaleOAPIDataSourceSubregionsFilters(set *schema.Set) (*oscgo.FiltersSubregion, error) {
 	filters := &oscgo.FiltersSubregion{}
 	for _, v := range set.List() {
 		m := v.(map[string]interface{})
@@ -109,8 +121,8 @@ func buildOutscaleOAPIDataSourceSubregionsFilters(set *schema.Set) *oscgo.Filter
 		case "subregion_names":
 			filters.SetSubregionNames(filterValues)
 		default:
-			log.Printf("[Debug] Unknown Filter Name: %s.", name)
+			return nil, fmt.Errorf("unknown filter name %q", name)
 		}
 	}
-	return filters
+	return filters, nil
 }
@@ -3,7 +3,6 @@ package outscale
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 	"time"
 
@@ -17,7 +16,7 @@ func dataSourceOutscaleOAPITag() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceOutscaleOAPITagRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter": dataSourceFiltersSchemaFor(tagFilters),
 			"key": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -45,9 +44,16 @@ func dataSourceOutscaleOAPITagRead(d *schema.ResourceData, meta interface{}) err
 	params := oscgo.ReadTagsRequest{}
 
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), tagFilters); err != nil {
+		return err
+	}
 
 	if filtersOk {
-		params.SetFilters(oapiBuildOutscaleDataSourceFilters(filters.(*schema.Set)))
+		filtersReq, err := oapiBuildOutscaleDataSourceFilters(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
+		params.SetFilters(filtersReq)
 	}
 
 	var resp oscgo.ReadTagsResponse
@@ -98,7 +104,15 @@ func dataSourceOutscaleOAPITagRead(d *schema.ResourceData, meta interface{}) err
 	return err
 }
 
-func oapiBuildOutscaleDataSourceFilters(set *schema.Set) oscgo.FiltersTag {
+// tagFilters are the filters supported by the tag data sources.
+var tagFilters = dataSourceFilters{
+	"keys":           filterString,
+	"resource_ids":   filterString,
+	"resource_types": filterString,
+	"values":         filterString,
+}
+
+func oapiBuildOutscaleDataSourceFilters(set *schema.Set) (oscgo.FiltersTag, error) {
 	filters := oscgo.FiltersTag{}
 	for _, v := range set.List() {
 		m := v.(map[string]interface{})
@@ -118,9 +132,9 @@ func oapiBuildOutscaleDataSourceFilters(set *schema.Set) oscgo.FiltersTag {
 		case "values":
 			filters.SetValues(filterValues)
 		default:
-			log.Printf("[Debug] Unknown Filter Name: %s.", name)
+			return oscgo.FiltersTag{}, fmt.Errorf("unknown filter name %q", name)
 		}
 	}
 
-	return filters
+	return filters, nil
 }
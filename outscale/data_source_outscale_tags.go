@@ -15,7 +15,7 @@ func dataSourceOutscaleOAPITags() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceOutscaleOAPITagsRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter": dataSourceFiltersSchemaFor(tagFilters),
 			"tags": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -50,9 +50,16 @@ func dataSourceOutscaleOAPITagsRead(d *schema.ResourceData, meta interface{}) er
 	// Build up search parameters
 	params := oscgo.ReadTagsRequest{}
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), tagFilters); err != nil {
+		return err
+	}
 
 	if filtersOk {
-		params.SetFilters(oapiBuildOutscaleDataSourceFilters(filters.(*schema.Set)))
+		filtersReq, err := oapiBuildOutscaleDataSourceFilters(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
+		params.SetFilters(filtersReq)
 	}
 
 	var resp oscgo.ReadTagsResponse
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	oscgo "github.com/outscale/osc-sdk-go/v2"
-	"log"
 	"strings"
 	"time"
 
@@ -18,7 +17,7 @@ func dataSourceOutscaleOAPIVirtualGateway() *schema.Resource {
 		Read: dataSourceOutscaleOAPIVirtualGatewayRead,
 
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter": dataSourceFiltersSchemaFor(virtualGatewayFilters),
 			"virtual_gateway_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -63,6 +62,9 @@ func dataSourceOutscaleOAPIVirtualGatewayRead(d *schema.ResourceData, meta inter
 	conn := meta.(*OutscaleClient).OSCAPI
 
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), virtualGatewayFilters); err != nil {
+		return err
+	}
 	virtualId, vpnOk := d.GetOk("virtual_gateway_id")
 
 	if !filtersOk && !vpnOk {
@@ -76,7 +78,11 @@ func dataSourceOutscaleOAPIVirtualGatewayRead(d *schema.ResourceData, meta inter
 	}
 
 	if filtersOk {
-		params.SetFilters(buildOutscaleAPIVirtualGatewayFilters(filters.(*schema.Set)))
+		filtersReq, err := buildOutscaleAPIVirtualGatewayFilters(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
+		params.SetFilters(filtersReq)
 	}
 
 	var resp oscgo.ReadVirtualGatewaysResponse
@@ -125,7 +131,19 @@ func dataSourceOutscaleOAPIVirtualGatewayRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func buildOutscaleAPIVirtualGatewayFilters(set *schema.Set) oscgo.FiltersVirtualGateway {
+// virtualGatewayFilters are the filters supported by the virtual gateway data sources.
+var virtualGatewayFilters = dataSourceFilters{
+	"tags":                filterString,
+	"tag_keys":            filterString,
+	"tag_values":          filterString,
+	"states":              filterString,
+	"connection_types":    filterString,
+	"link_net_ids":        filterString,
+	"link_states":         filterString,
+	"virtual_gateway_ids": filterString,
+}
+
+func buildOutscaleAPIVirtualGatewayFilters(set *schema.Set) (oscgo.FiltersVirtualGateway, error) {
 	var filters oscgo.FiltersVirtualGateway
 	for _, v := range set.List() {
 		m := v.(map[string]interface{})
@@ -153,8 +171,8 @@ func buildOutscaleAPIVirtualGatewayFilters(set *schema.Set) oscgo.FiltersVirtual
 		case "virtual_gateway_ids":
 			filters.SetVirtualGatewayIds(filterValues)
 		default:
-			log.Printf("[Debug] Unknown Filter Name: %s.", name)
+			return oscgo.FiltersVirtualGateway{}, fmt.Errorf("unknown filter name %q", name)
 		}
 	}
-	return filters
+	return filters, nil
 }
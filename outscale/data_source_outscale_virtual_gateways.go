@@ -16,7 +16,7 @@ func dataSourceOutscaleOAPIVirtualGateways() *schema.Resource {
 		Read: dataSourceOutscaleOAPIVirtualGatewaysRead,
 
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter": dataSourceFiltersSchemaFor(virtualGatewayFilters),
 			"virtual_gateway_id": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -72,6 +72,9 @@ func dataSourceOutscaleOAPIVirtualGatewaysRead(d *schema.ResourceData, meta inte
 	conn := meta.(*OutscaleClient).OSCAPI
 
 	filter, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filter.(*schema.Set), virtualGatewayFilters); err != nil {
+		return err
+	}
 	_, vpnOk := d.GetOk("virtual_gateway_id")
 
 	if !filtersOk && !vpnOk {
@@ -81,7 +84,11 @@ func dataSourceOutscaleOAPIVirtualGatewaysRead(d *schema.ResourceData, meta inte
 	params := oscgo.ReadVirtualGatewaysRequest{}
 
 	if filtersOk {
-		params.SetFilters(buildOutscaleAPIVirtualGatewayFilters(filter.(*schema.Set)))
+		filtersReq, err := buildOutscaleAPIVirtualGatewayFilters(filter.(*schema.Set))
+		if err != nil {
+			return err
+		}
+		params.SetFilters(filtersReq)
 	}
 
 	var resp oscgo.ReadVirtualGatewaysResponse
@@ -24,15 +24,23 @@ func dataSourceOutscaleOAPIVMRead(d *schema.ResourceData, meta interface{}) erro
 	client := meta.(*OutscaleClient).OSCAPI
 
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), vmFilters); err != nil {
+		return err
+	}
 	instanceID, instanceIDOk := d.GetOk("vm_id")
 
 	if !filtersOk && !instanceIDOk {
 		return fmt.Errorf("One of filters, or instance_id must be assigned")
 	}
 	// Build up search parameters
-	params := oscgo.ReadVmsRequest{}
+	params := oscgo.ReadVmsRequest{Filters: new(oscgo.FiltersVm)}
+	clientFilters := make(map[string][]string)
 	if filtersOk {
-		params.Filters = buildOutscaleOAPIDataSourceVMFilters(filters.(*schema.Set))
+		var err error
+		params.Filters, clientFilters, err = buildOutscaleOAPIDataSourceVMFilters(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
 	}
 	if instanceIDOk {
 		params.Filters.VmIds = &[]string{instanceID.(string)}
@@ -63,14 +71,7 @@ func dataSourceOutscaleOAPIVMRead(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again")
 	}
 
-	var filteredVms []oscgo.Vm
-
-	// loop through reservations, and remove terminated instances, populate vm slice
-	for _, res := range resp.GetVms() {
-		if res.GetState() != "terminated" {
-			filteredVms = append(filteredVms, res)
-		}
-	}
+	filteredVms := filterOAPIVMs(resp.GetVms(), clientFilters)
 
 	var vm oscgo.Vm
 	if len(filteredVms) < 1 {
@@ -231,7 +232,7 @@ func getOAPIVMSecurityGroups(groupSet []oscgo.SecurityGroupLight) []map[string]i
 
 func getDataSourceOAPIVMSchemas() map[string]*schema.Schema {
 	wholeSchema := map[string]*schema.Schema{
-		"filter": dataSourceFiltersSchema(),
+		"filter": dataSourceFiltersSchemaFor(vmFilters),
 	}
 
 	attrsSchema := getOApiVMAttributesSchema()
@@ -248,8 +249,76 @@ func getDataSourceOAPIVMSchemas() map[string]*schema.Schema {
 	return wholeSchema
 }
 
-func buildOutscaleOAPIDataSourceVMFilters(set *schema.Set) *oscgo.FiltersVm {
+// vmFilters are the filters supported by the VM data sources, the ones ReadVms doesn't
+// support are listed in vmClientSideFilters.
+var vmFilters = dataSourceFilters{
+	"image_ids":          filterString,
+	"keypair_names":      filterString,
+	"net_ids":            filterString,
+	"private_ips":        filterString,
+	"security_group_ids": filterString,
+	"subnet_ids":         filterString,
+	"subregion_names":    filterString,
+	"tag_keys":           filterString,
+	"tag_values":         filterString,
+	"tags":               filterString,
+	"vm_ids":             filterString,
+	"vm_states":          filterString,
+	"vm_types":           filterString,
+}
+
+// vmClientSideFilters are the filters ReadVms doesn't support, they are applied on its result.
+// Each function returns the values of the VM the filter values are matched against.
+var vmClientSideFilters = map[string]func(vm oscgo.Vm) []string{
+	"image_ids": func(vm oscgo.Vm) []string {
+		return []string{vm.GetImageId()}
+	},
+	"keypair_names": func(vm oscgo.Vm) []string {
+		return []string{vm.GetKeypairName()}
+	},
+	"net_ids": func(vm oscgo.Vm) []string {
+		return []string{vm.GetNetId()}
+	},
+	"private_ips": func(vm oscgo.Vm) []string {
+		ips := []string{vm.GetPrivateIp()}
+		for _, nic := range vm.GetNics() {
+			for _, ip := range nic.GetPrivateIps() {
+				ips = append(ips, ip.GetPrivateIp())
+			}
+		}
+		return ips
+	},
+	"security_group_ids": func(vm oscgo.Vm) []string {
+		ids := make([]string, 0, len(vm.GetSecurityGroups()))
+		for _, sg := range vm.GetSecurityGroups() {
+			ids = append(ids, sg.GetSecurityGroupId())
+		}
+		return ids
+	},
+	"subnet_ids": func(vm oscgo.Vm) []string {
+		ids := []string{vm.GetSubnetId()}
+		for _, nic := range vm.GetNics() {
+			ids = append(ids, nic.GetSubnetId())
+		}
+		return ids
+	},
+	"subregion_names": func(vm oscgo.Vm) []string {
+		placement := vm.GetPlacement()
+		return []string{placement.GetSubregionName()}
+	},
+	"vm_states": func(vm oscgo.Vm) []string {
+		return []string{vm.GetState()}
+	},
+	"vm_types": func(vm oscgo.Vm) []string {
+		return []string{vm.GetVmType()}
+	},
+}
+
+// buildOutscaleOAPIDataSourceVMFilters returns the filters sent to ReadVms and the ones to
+// apply with filterOAPIVMs on its result.
+func buildOutscaleOAPIDataSourceVMFilters(set *schema.Set) (*oscgo.FiltersVm, map[string][]string, error) {
 	filters := new(oscgo.FiltersVm)
+	clientFilters := make(map[string][]string)
 
 	for _, v := range set.List() {
 		m := v.(map[string]interface{})
@@ -268,10 +337,53 @@ func buildOutscaleOAPIDataSourceVMFilters(set *schema.Set) *oscgo.FiltersVm {
 		case "vm_ids":
 			filters.VmIds = &filterValues
 		default:
-			log.Printf("[Debug] Unknown Filter Name: %s.", name)
+			if _, ok := vmClientSideFilters[name]; !ok {
+				return nil, nil, fmt.Errorf("unknown VM filter name %q", name)
+			}
+			clientFilters[name] = filterValues
+		}
+	}
+	return filters, clientFilters, nil
+}
+
+// filterOAPIVMs returns the VMs matching all the client side filters. Terminated VMs are
+// dropped unless the vm_states filter asks for them.
+func filterOAPIVMs(vms []oscgo.Vm, clientFilters map[string][]string) []oscgo.Vm {
+	_, filterStates := clientFilters["vm_states"]
+
+	filtered := make([]oscgo.Vm, 0, len(vms))
+	for _, vm := range vms {
+		if vm.GetState() == "terminated" && !filterStates {
+			continue
+		}
+		if vmMatchesOAPIFilters(vm, clientFilters) {
+			filtered = append(filtered, vm)
+		}
+	}
+	return filtered
+}
+
+func vmMatchesOAPIFilters(vm oscgo.Vm, clientFilters map[string][]string) bool {
+	for name, values := range clientFilters {
+		if !anyStringMatches(vmClientSideFilters[name](vm), values) {
+			return false
+		}
+	}
+	return true
+}
+
+func anyStringMatches(attributes, values []string) bool {
+	for _, attribute := range attributes {
+		if attribute == "" {
+			continue
+		}
+		for _, value := range values {
+			if attribute == value {
+				return true
+			}
 		}
 	}
-	return filters
+	return false
 }
 
 func getOApiVMAttributesSchema() map[string]*schema.Schema {
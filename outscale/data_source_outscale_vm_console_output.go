@@ -0,0 +1,92 @@
+package outscale
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	oscgo "github.com/outscale/osc-sdk-go/v2"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-outscale/utils"
+)
+
+func dataSourceOutscaleOAPIVMConsoleOutput() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceOutscaleOAPIVMConsoleOutputRead,
+		Schema: map[string]*schema.Schema{
+			"vm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"console_output": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"request_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceOutscaleOAPIVMConsoleOutputRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*OutscaleClient).OSCAPI
+
+	vmID := d.Get("vm_id").(string)
+	consoleOutput, requestID, err := readOAPIVMConsoleOutput(conn, vmID)
+	if err != nil {
+		return fmt.Errorf("error reading the console output of the VM (%s): %s", vmID, err)
+	}
+
+	if err := d.Set("console_output", consoleOutput); err != nil {
+		return err
+	}
+	if err := d.Set("request_id", requestID); err != nil {
+		return err
+	}
+
+	d.SetId(vmID)
+	return nil
+}
+
+// readOAPIVMConsoleOutput returns the decoded console output of the VM vmID. It is empty
+// until the VM has booted.
+func readOAPIVMConsoleOutput(conn *oscgo.APIClient, vmID string) (string, string, error) {
+	req := oscgo.ReadConsoleOutputRequest{VmId: vmID}
+
+	var resp oscgo.ReadConsoleOutputResponse
+	var err error
+	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		resp, _, err = conn.VmApi.ReadConsoleOutput(context.Background()).ReadConsoleOutputRequest(req).Execute()
+		if err != nil {
+			if strings.Contains(fmt.Sprint(err), "RequestLimitExceeded:") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", "", utils.GetErrorResponse(err)
+	}
+
+	consoleOutput, err := base64.StdEncoding.DecodeString(resp.GetConsoleOutput())
+	if err != nil {
+		return "", "", fmt.Errorf("the console output is not encoded in Base64: %s", err)
+	}
+	return string(consoleOutput), resp.ResponseContext.GetRequestId(), nil
+}
+
+// lastLines returns the last n lines of s.
+func lastLines(s string, n int) string {
+	lines := strings.Split(strings.TrimRight(s, "\r\n"), "\n")
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,62 @@
+package outscale
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccOutscaleOAPIVMConsoleOutputDataSource_basic(t *testing.T) {
+	omi := os.Getenv("OUTSCALE_IMAGEID")
+	region := os.Getenv("OUTSCALE_REGION")
+	dataSourceName := "data.outscale_vm_console_output.console_output"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOAPIVMConsoleOutputDataSourceConfig(omi, "tinav4.c2r2p2", region),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "vm_id", "outscale_vm.outscale_vm", "vm_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "request_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestLastLines(t *testing.T) {
+	cases := []struct {
+		s        string
+		n        int
+		expected string
+	}{
+		{"a\nb\nc\n", 2, "b\nc"},
+		{"a\r\nb\r\nc\r\n", 1, "c"},
+		{"a\nb", 5, "a\nb"},
+		{"", 3, ""},
+	}
+	for _, c := range cases {
+		if got := lastLines(c.s, c.n); got != c.expected {
+			t.Errorf("lastLines(%q, %d): expected %q, got %q", c.s, c.n, c.expected, got)
+		}
+	}
+}
+
+func testAccOAPIVMConsoleOutputDataSourceConfig(omi, vmType, region string) string {
+	return fmt.Sprintf(`
+		resource "outscale_vm" "outscale_vm" {
+			image_id                 = "%s"
+			vm_type                  = "%s"
+			keypair_name             = "terraform-basic"
+			placement_subregion_name = "%sa"
+		}
+
+		data "outscale_vm_console_output" "console_output" {
+			vm_id = "${outscale_vm.outscale_vm.vm_id}"
+		}
+	`, omi, vmType, region)
+}
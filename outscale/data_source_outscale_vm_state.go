@@ -23,7 +23,7 @@ func dataSourceOutscaleOAPIVMState() *schema.Resource {
 
 func getOAPIVMStateDataSourceSchema() map[string]*schema.Schema {
 	wholeSchema := map[string]*schema.Schema{
-		"filter": dataSourceFiltersSchema(),
+		"filter": dataSourceFiltersSchemaFor(vmStateFilters),
 	}
 
 	for k, v := range getVMStateAttrsSchema() {
@@ -83,6 +83,9 @@ func dataSourceOutscaleOAPIVMStateRead(d *schema.ResourceData, meta interface{})
 	conn := meta.(*OutscaleClient).OSCAPI
 
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), vmStateFilters); err != nil {
+		return err
+	}
 	instanceID, instanceIDOk := d.GetOk("vm_id")
 
 	if !instanceIDOk && !filtersOk {
@@ -91,7 +94,11 @@ func dataSourceOutscaleOAPIVMStateRead(d *schema.ResourceData, meta interface{})
 
 	params := oscgo.ReadVmsStateRequest{}
 	if filtersOk {
-		params.SetFilters(buildOutscaleOAPIDataSourceVMStateFilters(filters.(*schema.Set)))
+		filtersReq, err := buildOutscaleOAPIDataSourceVMStateFilters(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
+		params.SetFilters(filtersReq)
 	}
 	if instanceIDOk {
 		filter := oscgo.FiltersVmsState{}
@@ -177,7 +184,18 @@ func statusSetOAPIVMState(status []oscgo.MaintenanceEvent) []map[string]interfac
 	return s
 }
 
-func buildOutscaleOAPIDataSourceVMStateFilters(set *schema.Set) oscgo.FiltersVmsState {
+// vmStateFilters are the filters supported by the VM state data sources.
+var vmStateFilters = dataSourceFilters{
+	"maintenance_event_codes":        filterString,
+	"maintenance_event_descriptions": filterString,
+	"maintenance_events_not_after":   filterDate,
+	"maintenance_events_not_before":  filterDate,
+	"subregion_names":                filterString,
+	"vm_ids":                         filterString,
+	"vm_states":                      filterString,
+}
+
+func buildOutscaleOAPIDataSourceVMStateFilters(set *schema.Set) (oscgo.FiltersVmsState, error) {
 	var filters oscgo.FiltersVmsState
 	for _, v := range set.List() {
 		m := v.(map[string]interface{})
@@ -203,8 +221,8 @@ func buildOutscaleOAPIDataSourceVMStateFilters(set *schema.Set) oscgo.FiltersVms
 			filters.SetVmStates(filterValues)
 
 		default:
-			log.Printf("[Debug] Unknown Filter Name: %s.", name)
+			return oscgo.FiltersVmsState{}, fmt.Errorf("unknown filter name %q", name)
 		}
 	}
-	return filters
+	return filters, nil
 }
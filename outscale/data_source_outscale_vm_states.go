@@ -22,7 +22,7 @@ func dataSourceOutscaleOAPIVMStates() *schema.Resource {
 
 func getOAPIVMStatesDataSourceSchema() map[string]*schema.Schema {
 	wholeSchema := map[string]*schema.Schema{
-		"filter": dataSourceFiltersSchema(),
+		"filter": dataSourceFiltersSchemaFor(vmStateFilters),
 		"vm_ids": {
 			Type:     schema.TypeList,
 			Optional: true,
@@ -48,6 +48,9 @@ func dataSourceOutscaleOAPIVMStatesRead(d *schema.ResourceData, meta interface{}
 	conn := meta.(*OutscaleClient).OSCAPI
 
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), vmStateFilters); err != nil {
+		return err
+	}
 	instanceIds, instanceIdsOk := d.GetOk("vm_ids")
 
 	if !instanceIdsOk && !filtersOk {
@@ -56,7 +59,11 @@ func dataSourceOutscaleOAPIVMStatesRead(d *schema.ResourceData, meta interface{}
 
 	params := oscgo.ReadVmsStateRequest{}
 	if filtersOk {
-		params.SetFilters(buildOutscaleOAPIDataSourceVMStateFilters(filters.(*schema.Set)))
+		filtersReq, err := buildOutscaleOAPIDataSourceVMStateFilters(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
+		params.SetFilters(filtersReq)
 	}
 	if instanceIdsOk {
 		filter := oscgo.FiltersVmsState{}
@@ -6,6 +6,8 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	oscgo "github.com/outscale/osc-sdk-go/v2"
 )
 
 func TestAccOutscaleOAPIVMDataSource_basic(t *testing.T) {
@@ -65,3 +67,46 @@ func testAccOAPIVMDataSourceConfig(omi, vmType string) string {
 		}
 	`, omi, vmType)
 }
+
+func TestFilterOAPIVMs(t *testing.T) {
+	vms := []oscgo.Vm{
+		{VmId: oscgo.PtrString("i-00000001"), State: oscgo.PtrString("running"), SubnetId: oscgo.PtrString("subnet-1"),
+			SecurityGroups: &[]oscgo.SecurityGroupLight{{SecurityGroupId: oscgo.PtrString("sg-1")}}},
+		{VmId: oscgo.PtrString("i-00000002"), State: oscgo.PtrString("stopped"), SubnetId: oscgo.PtrString("subnet-2"),
+			SecurityGroups: &[]oscgo.SecurityGroupLight{{SecurityGroupId: oscgo.PtrString("sg-1")}}},
+		{VmId: oscgo.PtrString("i-00000003"), State: oscgo.PtrString("terminated"), SubnetId: oscgo.PtrString("subnet-1")},
+	}
+
+	cases := []struct {
+		filters  map[string][]string
+		expected []string
+	}{
+		{map[string][]string{}, []string{"i-00000001", "i-00000002"}},
+		{map[string][]string{"subnet_ids": {"subnet-1"}}, []string{"i-00000001"}},
+		{map[string][]string{"subnet_ids": {"subnet-1", "subnet-2"}, "vm_states": {"stopped"}}, []string{"i-00000002"}},
+		{map[string][]string{"vm_states": {"terminated"}}, []string{"i-00000003"}},
+		{map[string][]string{"security_group_ids": {"sg-1"}}, []string{"i-00000001", "i-00000002"}},
+		{map[string][]string{"security_group_ids": {"sg-2"}}, []string{}},
+	}
+
+	for _, c := range cases {
+		filtered := filterOAPIVMs(vms, c.filters)
+		ids := make([]string, 0, len(filtered))
+		for _, vm := range filtered {
+			ids = append(ids, vm.GetVmId())
+		}
+		if fmt.Sprint(ids) != fmt.Sprint(c.expected) {
+			t.Errorf("filters %v: expected %v, got %v", c.filters, c.expected, ids)
+		}
+	}
+}
+
+func TestBuildOutscaleOAPIDataSourceVMFilters_unknownName(t *testing.T) {
+	set := schema.NewSet(schema.HashResource(dataSourceFiltersSchema().Elem.(*schema.Resource)), []interface{}{
+		map[string]interface{}{"name": "subnet_id", "values": []interface{}{"subnet-1"}},
+	})
+
+	if _, _, err := buildOutscaleOAPIDataSourceVMFilters(set); err == nil {
+		t.Fatal("expected an error for an unknown filter name")
+	}
+}
@@ -3,7 +3,6 @@ package outscale
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 	"time"
 
@@ -19,7 +18,7 @@ func dataSourceOutscaleOAPIVMTypes() *schema.Resource {
 		Read: dataSourceOutscaleOAPIVMTypesRead,
 
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter": dataSourceFiltersSchemaFor(vmTypeFilters),
 			"vm_types": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -68,10 +67,17 @@ func dataSourceOutscaleOAPIVMTypesRead(d *schema.ResourceData, meta interface{})
 	conn := meta.(*OutscaleClient).OSCAPI
 
 	filter, filterOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filter.(*schema.Set), vmTypeFilters); err != nil {
+		return err
+	}
 	filtersReq := oscgo.FiltersVmType{}
 
 	if filterOk {
-		filtersReq = buildOutscaleOAPIDataSourceVMTypesFilters(filter.(*schema.Set))
+		var err error
+		filtersReq, err = buildOutscaleOAPIDataSourceVMTypesFilters(filter.(*schema.Set))
+		if err != nil {
+			return err
+		}
 	}
 	req := oscgo.ReadVmTypesRequest{Filters: &filtersReq}
 
@@ -154,7 +160,17 @@ func statusDescriptionOAPIVMTypesAttributes(d *schema.ResourceData, fTypes []osc
 
 }
 
-func buildOutscaleOAPIDataSourceVMTypesFilters(set *schema.Set) oscgo.FiltersVmType {
+// vmTypeFilters are the filters supported by the VM type data sources.
+var vmTypeFilters = dataSourceFilters{
+	"bsu_optimized": filterBool,
+	"memory_sizes":  filterFloat,
+	"vcore_counts":  filterInt,
+	"vm_type_names": filterString,
+	"volume_counts": filterInt,
+	"volume_sizes":  filterInt,
+}
+
+func buildOutscaleOAPIDataSourceVMTypesFilters(set *schema.Set) (oscgo.FiltersVmType, error) {
 	var filters oscgo.FiltersVmType
 	for _, v := range set.List() {
 		m := v.(map[string]interface{})
@@ -177,8 +193,8 @@ func buildOutscaleOAPIDataSourceVMTypesFilters(set *schema.Set) oscgo.FiltersVmT
 		case "volume_sizes":
 			filters.SetVolumeSizes(utils.StringSliceToInt32Slice(filterValues))
 		default:
-			log.Printf("[Debug] Unknown Filter Name: %s.", name)
+			return oscgo.FiltersVmType{}, fmt.Errorf("unknown filter name %q", name)
 		}
 	}
-	return filters
+	return filters, nil
 }
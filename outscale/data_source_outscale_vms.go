@@ -45,7 +45,7 @@ func dataSourceFiltersSchema() *schema.Schema {
 
 func datasourceOutscaleOApiVMSSchema() map[string]*schema.Schema {
 	wholeSchema := map[string]*schema.Schema{
-		"filter": dataSourceFiltersSchema(),
+		"filter": dataSourceFiltersSchemaFor(vmFilters),
 		"vms": {
 			Type:     schema.TypeList,
 			Computed: true,
@@ -66,6 +66,9 @@ func dataSourceOutscaleOApiVMSRead(d *schema.ResourceData, meta interface{}) err
 	client := meta.(*OutscaleClient).OSCAPI
 
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), vmFilters); err != nil {
+		return err
+	}
 	vmID, vmIDOk := d.GetOk("vm_id")
 
 	if !filtersOk && !vmIDOk {
@@ -73,9 +76,14 @@ func dataSourceOutscaleOApiVMSRead(d *schema.ResourceData, meta interface{}) err
 	}
 
 	// Build up search parameters
-	params := oscgo.ReadVmsRequest{}
+	params := oscgo.ReadVmsRequest{Filters: new(oscgo.FiltersVm)}
+	clientFilters := make(map[string][]string)
 	if filtersOk {
-		params.Filters = buildOutscaleOAPIDataSourceVMFilters(filters.(*schema.Set))
+		var err error
+		params.Filters, clientFilters, err = buildOutscaleOAPIDataSourceVMFilters(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
 	}
 	if vmIDOk {
 		params.Filters.VmIds = &[]string{vmID.(string)}
@@ -105,14 +113,7 @@ func dataSourceOutscaleOApiVMSRead(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again")
 	}
 
-	var filteredVms []oscgo.Vm
-
-	// loop through reservations, and remove terminated instances, populate vm slice
-	for _, res := range resp.GetVms() {
-		if res.GetState() != "terminated" {
-			filteredVms = append(filteredVms, res)
-		}
-	}
+	filteredVms := filterOAPIVMs(resp.GetVms(), clientFilters)
 
 	if len(filteredVms) < 1 {
 		return errors.New("Your query returned no results. Please change your search criteria and try again")
@@ -3,6 +3,7 @@ package outscale
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -28,6 +29,30 @@ func TestAccOutscaleOAPIVMSDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccOutscaleOAPIVMSDataSource_clientSideFilters(t *testing.T) {
+	omi := os.Getenv("OUTSCALE_IMAGEID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOAPIVMSDataSourceConfigClientSideFilters(omi, "tinav4.c2r2p2", "vm_types"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.outscale_vms.basic_web", "vms.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.outscale_vms.basic_web", "vms.0.vm_type", "tinav4.c2r2p2"),
+				),
+			},
+			{
+				Config:      testAccOAPIVMSDataSourceConfigClientSideFilters(omi, "tinav4.c2r2p2", "vm_typos"),
+				ExpectError: regexp.MustCompile("unknown VM filter name"),
+			},
+		},
+	})
+}
+
 func testAccOAPIVMSDataSourceConfig(omi, vmType string) string {
 	return fmt.Sprintf(`
 		resource "outscale_vm" "basic" {
@@ -43,3 +68,27 @@ func testAccOAPIVMSDataSourceConfig(omi, vmType string) string {
 			}
 		}`, omi, vmType)
 }
+
+func testAccOAPIVMSDataSourceConfigClientSideFilters(omi, vmType, filterName string) string {
+	return fmt.Sprintf(`
+		resource "outscale_vm" "basic" {
+			image_id     = "%[1]s"
+			vm_type      = "%[2]s"
+			keypair_name = "terraform-basic"
+		}
+
+		data "outscale_vms" "basic_web" {
+			filter {
+				name   = "vm_ids"
+				values = [outscale_vm.basic.id]
+			}
+			filter {
+				name   = "%[3]s"
+				values = ["%[2]s"]
+			}
+			filter {
+				name   = "vm_states"
+				values = ["running", "stopped"]
+			}
+		}`, omi, vmType, filterName)
+}
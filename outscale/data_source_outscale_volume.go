@@ -22,7 +22,7 @@ func datasourceOutscaleOAPIVolume() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			// Arguments
-			"filter": dataSourceFiltersSchema(),
+			"filter": dataSourceFiltersSchemaFor(volumeFilters),
 			"subregion_name": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -93,6 +93,9 @@ func datasourceOAPIVolumeRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*OutscaleClient).OSCAPI
 
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), volumeFilters); err != nil {
+		return err
+	}
 	volumeIds, VolumeIdsOk := d.GetOk("volume_id")
 
 	params := oscgo.ReadVolumesRequest{
@@ -103,7 +106,11 @@ func datasourceOAPIVolumeRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if filtersOk {
-		params.SetFilters(buildOutscaleOSCAPIDataSourceVolumesFilters(filters.(*schema.Set)))
+		filtersReq, err := buildOutscaleOSCAPIDataSourceVolumesFilters(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
+		params.SetFilters(filtersReq)
 	}
 
 	var resp oscgo.ReadVolumesResponse
@@ -208,7 +215,26 @@ func volumeOAPIDescriptionAttributes(d *schema.ResourceData, volume *oscgo.Volum
 	return nil
 }
 
-func buildOutscaleOSCAPIDataSourceVolumesFilters(set *schema.Set) oscgo.FiltersVolume {
+// volumeFilters are the filters supported by the volume data sources.
+var volumeFilters = dataSourceFilters{
+	"creation_dates":                    filterDate,
+	"snapshot_ids":                      filterString,
+	"subregion_names":                   filterString,
+	"tags":                              filterString,
+	"tag_keys":                          filterString,
+	"tag_values":                        filterString,
+	"volume_ids":                        filterString,
+	"volume_sizes":                      filterInt,
+	"volume_types":                      filterString,
+	"link_volume_vm_ids":                filterString,
+	"volume_states":                     filterString,
+	"link_volume_link_states":           filterString,
+	"link_volume_delete_on_vm_deletion": filterBool,
+	"link_volume_link_dates":            filterDate,
+	"link_volume_device_names":          filterString,
+}
+
+func buildOutscaleOSCAPIDataSourceVolumesFilters(set *schema.Set) (oscgo.FiltersVolume, error) {
 	var filters oscgo.FiltersVolume
 	for _, v := range set.List() {
 		m := v.(map[string]interface{})
@@ -243,14 +269,14 @@ func buildOutscaleOSCAPIDataSourceVolumesFilters(set *schema.Set) oscgo.FiltersV
 		case "link_volume_link_states":
 			filters.SetLinkVolumeLinkStates(filterValues)
 		case "link_volume_delete_on_vm_deletion":
-			filters.SetLinkVolumeDeleteOnVmDeletion(cast.ToBool(filterValues))
+			filters.SetLinkVolumeDeleteOnVmDeletion(cast.ToBool(filterValues[0]))
 		case "link_volume_link_dates":
 			filters.SetLinkVolumeLinkDates(filterValues)
 		case "link_volume_device_names":
 			filters.SetLinkVolumeDeviceNames(filterValues)
 		default:
-			log.Printf("[Debug] Unknown Filter Name: %s.", name)
+			return oscgo.FiltersVolume{}, fmt.Errorf("unknown filter name %q", name)
 		}
 	}
-	return filters
+	return filters, nil
 }
@@ -19,7 +19,7 @@ func datasourceOutscaleOAPIVolumes() *schema.Resource {
 		Read: datasourceOAPIVolumesRead,
 
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter": dataSourceFiltersSchemaFor(volumeFilters),
 			"volume_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -101,6 +101,9 @@ func datasourceOAPIVolumesRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*OutscaleClient).OSCAPI
 
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), volumeFilters); err != nil {
+		return err
+	}
 	volumeIds, volumeIdsOk := d.GetOk("volume_id")
 	params := oscgo.ReadVolumesRequest{
 		Filters: &oscgo.FiltersVolume{},
@@ -114,7 +117,11 @@ func datasourceOAPIVolumesRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if filtersOk {
-		params.SetFilters(buildOutscaleOSCAPIDataSourceVolumesFilters(filters.(*schema.Set)))
+		filtersReq, err := buildOutscaleOSCAPIDataSourceVolumesFilters(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
+		params.SetFilters(filtersReq)
 	}
 
 	log.Printf("LOG____ params: %#+v\n", params.GetFilters())
@@ -3,7 +3,6 @@ package outscale
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 	"time"
 
@@ -18,7 +17,7 @@ func dataSourceOutscaleVPNConnection() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceOutscaleVPNConnectionRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter": dataSourceFiltersSchemaFor(vpnConnectionFilters),
 			"vpn_connection_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -80,6 +79,9 @@ func dataSourceOutscaleVPNConnectionRead(d *schema.ResourceData, meta interface{
 	conn := meta.(*OutscaleClient).OSCAPI
 
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), vpnConnectionFilters); err != nil {
+		return err
+	}
 	vpnConnectionID, vpnConnectionOk := d.GetOk("vpn_connection_id")
 
 	if !filtersOk && !vpnConnectionOk {
@@ -95,7 +97,11 @@ func dataSourceOutscaleVPNConnectionRead(d *schema.ResourceData, meta interface{
 	}
 
 	if filtersOk {
-		params.Filters = buildOutscaleDataSourceVPNConnectionFilters(filters.(*schema.Set))
+		var err error
+		params.Filters, err = buildOutscaleDataSourceVPNConnectionFilters(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
 	}
 
 	var resp oscgo.ReadVpnConnectionsResponse
@@ -157,7 +163,22 @@ func dataSourceOutscaleVPNConnectionRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func buildOutscaleDataSourceVPNConnectionFilters(set *schema.Set) *oscgo.FiltersVpnConnection {
+// vpnConnectionFilters are the filters supported by the VPN connection data sources.
+var vpnConnectionFilters = dataSourceFilters{
+	"vpn_connection_ids":          filterString,
+	"virtual_gateway_ids":         filterString,
+	"client_gateway_ids":          filterString,
+	"connection_types":            filterString,
+	"route_destination_ip_ranges": filterString,
+	"states":                      filterString,
+	"static_routes_only":          filterBool,
+	"bgp_asns":                    filterInt,
+	"tag_keys":                    filterString,
+	"tag_values":                  filterString,
+	"tags":                        filterString,
+}
+
+func buildOutscaleDataSourceVPNConnectionFilters(set *schema.Set) (*oscgo.FiltersVpnConnection, error) {
 	var filters oscgo.FiltersVpnConnection
 	for _, v := range set.List() {
 		m := v.(map[string]interface{})
@@ -195,8 +216,8 @@ func buildOutscaleDataSourceVPNConnectionFilters(set *schema.Set) *oscgo.Filters
 		case "tags":
 			filters.SetTags(filterValues)
 		default:
-			log.Printf("[Debug] Unknown Filter Name: %s.", name)
+			return nil, fmt.Errorf("unknown filter name %q", name)
 		}
 	}
-	return &filters
+	return &filters, nil
 }
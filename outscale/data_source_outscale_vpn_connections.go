@@ -17,7 +17,7 @@ func dataSourceOutscaleVPNConnections() *schema.Resource {
 		Read: dataSourceOutscaleVPNConnectionsRead,
 
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter": dataSourceFiltersSchemaFor(vpnConnectionFilters),
 			"vpn_connection_ids": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -92,6 +92,9 @@ func dataSourceOutscaleVPNConnectionsRead(d *schema.ResourceData, meta interface
 	conn := meta.(*OutscaleClient).OSCAPI
 
 	filters, filtersOk := d.GetOk("filter")
+	if err := validateDataSourceFilters(filters.(*schema.Set), vpnConnectionFilters); err != nil {
+		return err
+	}
 	vpnConnectionIDs, vpnConnectionOk := d.GetOk("vpn_connection_ids")
 
 	if !filtersOk && !vpnConnectionOk {
@@ -108,7 +111,11 @@ func dataSourceOutscaleVPNConnectionsRead(d *schema.ResourceData, meta interface
 	}
 
 	if filtersOk {
-		params.Filters = buildOutscaleDataSourceVPNConnectionFilters(filters.(*schema.Set))
+		var err error
+		params.Filters, err = buildOutscaleDataSourceVPNConnectionFilters(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
 	}
 
 	var resp oscgo.ReadVpnConnectionsResponse
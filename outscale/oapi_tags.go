@@ -13,19 +13,31 @@ import (
 	oscgo "github.com/outscale/osc-sdk-go/v2"
 )
 
-func setOSCAPITags(conn *oscgo.APIClient, d *schema.ResourceData) error {
+func setOSCAPITags(client *OutscaleClient, d *schema.ResourceData) error {
+	return setOSCAPIResourcesTags(client, d, []string{d.Id()})
+}
+
+// setOSCAPIResourcesTags updates the tags of the resources resourceIDs, when a Terraform
+// resource manages several of them.
+func setOSCAPIResourcesTags(client *OutscaleClient, d *schema.ResourceData, resourceIDs []string) error {
+	conn := client.OSCAPI
 
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChange("tags") || d.HasChange("tags_all") {
+		oraw, _ := d.GetChange("tags_all")
 		o := oraw.(*schema.Set)
-		n := nraw.(*schema.Set)
-		create, remove := diffOSCAPITags(tagsFromSliceMap(o), tagsFromSliceMap(n))
+		// States written before tags_all was introduced only know about tags.
+		if o.Len() == 0 {
+			oraw, _ = d.GetChange("tags")
+			o = oraw.(*schema.Set)
+		}
+		n := mergeDefaultTags(client.DefaultTags, tagsFromSliceMap(d.Get("tags").(*schema.Set)))
+		create, remove := diffOSCAPITags(tagsFromSliceMap(o), n)
 
 		// Set tag
 		if len(remove) > 0 {
 			err := resource.Retry(60*time.Second, func() *resource.RetryError {
 				_, _, err := conn.TagApi.DeleteTags(context.Background()).DeleteTagsRequest(oscgo.DeleteTagsRequest{
-					ResourceIds: []string{d.Id()},
+					ResourceIds: resourceIDs,
 					Tags:        remove,
 				}).Execute()
 				if err != nil {
@@ -43,7 +55,7 @@ func setOSCAPITags(conn *oscgo.APIClient, d *schema.ResourceData) error {
 		if len(create) > 0 {
 			err := resource.Retry(60*time.Second, func() *resource.RetryError {
 				_, _, err := conn.TagApi.CreateTags(context.Background()).CreateTagsRequest(oscgo.CreateTagsRequest{
-					ResourceIds: []string{d.Id()},
+					ResourceIds: resourceIDs,
 					Tags:        create,
 				}).Execute()
 				if err != nil {
@@ -63,6 +75,57 @@ func setOSCAPITags(conn *oscgo.APIClient, d *schema.ResourceData) error {
 	return nil
 }
 
+// setOSCAPITagsAttributes sets tags without the provider default tags the resource doesn't
+// declare itself, so they don't show up as drift, and tags_all with every tag of the resource.
+func setOSCAPITagsAttributes(d *schema.ResourceData, client *OutscaleClient, tags []oscgo.ResourceTag) error {
+	configured := tagsFromSliceMap(d.Get("tags").(*schema.Set))
+	if err := d.Set("tags", tagsOSCAPIToMap(ignoreDefaultTags(client.DefaultTags, tags, configured))); err != nil {
+		return err
+	}
+	return d.Set("tags_all", tagsOSCAPIToMap(tags))
+}
+
+// mergeDefaultTags returns the provider default tags overridden by the resource tags.
+func mergeDefaultTags(defaultTags map[string]string, tags []oscgo.ResourceTag) []oscgo.ResourceTag {
+	merged := make(map[string]interface{}, len(defaultTags)+len(tags))
+	for k, v := range defaultTags {
+		merged[k] = v
+	}
+	for _, t := range tags {
+		merged[t.Key] = t.Value
+	}
+	return tagsOSCAPIFromMap(merged)
+}
+
+// ignoreDefaultTags removes from tags the provider default tags which are not configured on the resource.
+func ignoreDefaultTags(defaultTags map[string]string, tags, configured []oscgo.ResourceTag) []oscgo.ResourceTag {
+	declared := make(map[string]string, len(configured))
+	for _, t := range configured {
+		declared[t.Key] = t.Value
+	}
+
+	result := make([]oscgo.ResourceTag, 0, len(tags))
+	for _, t := range tags {
+		if v, ok := defaultTags[t.Key]; ok && v == t.Value {
+			if _, ok := declared[t.Key]; !ok {
+				continue
+			}
+		}
+		result = append(result, t)
+	}
+	return result
+}
+
+// customizeDiffOAPITagsAll plans tags_all from the resource tags and the provider default tags.
+func customizeDiffOAPITagsAll(diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("tags") {
+		return diff.SetNewComputed("tags_all")
+	}
+
+	tags := tagsFromSliceMap(diff.Get("tags").(*schema.Set))
+	return diff.SetNew("tags_all", tagsOSCAPIToMap(mergeDefaultTags(meta.(*OutscaleClient).DefaultTags, tags)))
+}
+
 func tagsOAPIListSchemaComputed() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeSet,
@@ -243,12 +306,19 @@ func oapiTagDescIgnored(t *oscgo.Tag) bool {
 	return false
 }
 
-func assignTags(tag *schema.Set, resourceID string, conn *oscgo.APIClient) error {
+func assignTags(tag *schema.Set, resourceID string, client *OutscaleClient) error {
+	return assignResourcesTags(tag, []string{resourceID}, client)
+}
+
+func assignResourcesTags(tag *schema.Set, resourceIDs []string, client *OutscaleClient) error {
 	request := oscgo.CreateTagsRequest{}
-	request.Tags = tagsFromSliceMap(tag)
-	request.ResourceIds = []string{resourceID}
+	request.Tags = mergeDefaultTags(client.DefaultTags, tagsFromSliceMap(tag))
+	request.ResourceIds = resourceIDs
+	if len(request.Tags) == 0 {
+		return nil
+	}
 	err := resource.Retry(60*time.Second, func() *resource.RetryError {
-		_, _, err := conn.TagApi.CreateTags(context.Background()).CreateTagsRequest(request).Execute()
+		_, _, err := client.OSCAPI.TagApi.CreateTags(context.Background()).CreateTagsRequest(request).Execute()
 
 		if err != nil {
 			if strings.Contains(fmt.Sprint(err), "NotFound") {
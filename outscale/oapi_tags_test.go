@@ -0,0 +1,53 @@
+package outscale
+
+import (
+	"testing"
+
+	oscgo "github.com/outscale/osc-sdk-go/v2"
+)
+
+func TestMergeDefaultTags(t *testing.T) {
+	defaultTags := map[string]string{"cost-center": "default", "team": "infra"}
+	tags := []oscgo.ResourceTag{{Key: "team", Value: "web"}, {Key: "name", Value: "vm"}}
+
+	merged := make(map[string]string)
+	for _, tag := range mergeDefaultTags(defaultTags, tags) {
+		merged[tag.Key] = tag.Value
+	}
+
+	expected := map[string]string{"cost-center": "default", "team": "web", "name": "vm"}
+	if len(merged) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, merged)
+	}
+	for k, v := range expected {
+		if merged[k] != v {
+			t.Fatalf("expected %v, got %v", expected, merged)
+		}
+	}
+}
+
+func TestIgnoreDefaultTags(t *testing.T) {
+	defaultTags := map[string]string{"cost-center": "default", "team": "infra", "env": "prod"}
+	remote := []oscgo.ResourceTag{
+		{Key: "cost-center", Value: "default"},
+		{Key: "team", Value: "web"},
+		{Key: "env", Value: "prod"},
+		{Key: "name", Value: "vm"},
+	}
+	configured := []oscgo.ResourceTag{{Key: "env", Value: "prod"}}
+
+	result := make(map[string]string)
+	for _, tag := range ignoreDefaultTags(defaultTags, remote, configured) {
+		result[tag.Key] = tag.Value
+	}
+
+	expected := map[string]string{"team": "web", "env": "prod", "name": "vm"}
+	if len(result) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, result)
+	}
+	for k, v := range expected {
+		if result[k] != v {
+			t.Fatalf("expected %v, got %v", expected, result)
+		}
+	}
+}
@@ -1,7 +1,13 @@
 package outscale
 
 import (
+	"fmt"
+	"log"
+	"os"
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 )
 
@@ -19,23 +25,35 @@ func Provider() terraform.ResourceProvider {
 		Schema: map[string]*schema.Schema{
 			"access_key_id": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("OUTSCALE_ACCESSKEYID", nil),
 				Description: "The Access Key ID for API operations.",
 			},
 			"secret_key_id": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("OUTSCALE_SECRETKEYID", nil),
 				Description: "The Secret Key ID for API operations.",
 			},
 			"region": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("OUTSCALE_REGION", nil),
 				Description: "The Region for API operations.",
 			},
 			"endpoints": endpointsSchema(),
+			"profile": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OSC_PROFILE", nil),
+				Description: "The profile of the configuration file to load the settings from.",
+			},
+			"config_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OSC_CONFIG_FILE", nil),
+				Description: "The path to the configuration file of the OUTSCALE CLIs, defaults to ~/.osc/config.json.",
+			},
 			"x509_cert_path": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -48,10 +66,26 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc("OUTSCALE_X509KEY", nil),
 				Description: "The path to your x509 key",
 			},
+			"default_tags": defaultTagsSchema(),
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultMaxRetries,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The maximum number of times a throttled or failed API request is retried.",
+			},
+			"retry_max_backoff": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      int(defaultRetryMaxBackoff.Seconds()),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The maximum time to wait between two retries of an API request, in seconds.",
+			},
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
 			"outscale_vm":                                resourceOutscaleOApiVM(),
+			"outscale_vms":                               resourceOutscaleOAPIVMs(),
 			"outscale_keypair":                           resourceOutscaleOAPIKeyPair(),
 			"outscale_image":                             resourceOutscaleOAPIImage(),
 			"outscale_internet_service_link":             resourceOutscaleOAPIInternetServiceLink(),
@@ -90,14 +124,21 @@ func Provider() terraform.ResourceProvider {
 			"outscale_access_key":                        resourceOutscaleAccessKey(),
 			"outscale_load_balancer":                     resourceOutscaleOAPILoadBalancer(),
 			"outscale_load_balancer_policy":              resourceOutscaleAppCookieStickinessPolicy(),
+			"outscale_load_balancer_vm":                  resourceOutscaleOAPILoadBalancerVM(),
 			"outscale_load_balancer_vms":                 resourceOutscaleOAPILBUAttachment(),
 			"outscale_load_balancer_attributes":          resourceOutscaleOAPILoadBalancerAttributes(),
+			"outscale_load_balancer_listener":            resourceOutscaleOAPILoadBalancerListener(),
 			"outscale_load_balancer_listener_rule":       resourceOutscaleLoadBalancerListenerRule(),
 			"outscale_flexible_gpu":                      resourceOutscaleOAPIFlexibleGpu(),
 			"outscale_flexible_gpu_link":                 resourceOutscaleOAPIFlexibleGpuLink(),
 			"outscale_image_export_task":                 resourceOutscaleOAPIIMageExportTask(),
 			"outscale_server_certificate":                resourceOutscaleOAPIServerCertificate(),
 			"outscale_snapshot_export_task":              resourceOutscaleOAPISnapshotExportTask(),
+			"outscale_ca":                                resourceOutscaleCa(),
+			"outscale_api_access_rule":                   resourceOutscaleApiAccessRule(),
+			"outscale_api_access_policy":                 resourceOutscaleApiAccessPolicy(),
+			"outscale_direct_link":                       resourceOutscaleDirectLink(),
+			"outscale_direct_link_interface":             resourceOutscaleDirectLinkInterface(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 			"outscale_vm":                           dataSourceOutscaleOAPIVM(),
@@ -118,6 +159,7 @@ func Provider() terraform.ResourceProvider {
 			"outscale_keypairs":                     datasourceOutscaleOAPIKeyPairs(),
 			"outscale_vm_state":                     dataSourceOutscaleOAPIVMState(),
 			"outscale_vm_states":                    dataSourceOutscaleOAPIVMStates(),
+			"outscale_vm_console_output":            dataSourceOutscaleOAPIVMConsoleOutput(),
 			"outscale_internet_service":             datasourceOutscaleOAPIInternetService(),
 			"outscale_internet_services":            datasourceOutscaleOAPIInternetServices(),
 			"outscale_subnet":                       dataSourceOutscaleOAPISubnet(),
@@ -168,6 +210,11 @@ func Provider() terraform.ResourceProvider {
 			"outscale_server_certificates":          datasourceOutscaleOAPIServerCertificates(),
 			"outscale_snapshot_export_task":         dataSourceOutscaleOAPISnapshotExportTask(),
 			"outscale_snapshot_export_tasks":        dataSourceOutscaleOAPISnapshotExportTasks(),
+			"outscale_cas":                          dataSourceOutscaleCas(),
+			"outscale_api_access_rules":             dataSourceOutscaleApiAccessRules(),
+			"outscale_api_access_policy":            dataSourceOutscaleApiAccessPolicy(),
+			"outscale_direct_links":                 dataSourceOutscaleDirectLinks(),
+			"outscale_locations":                    dataSourceOutscaleLocations(),
 		},
 
 		ConfigureFunc: providerConfigureClient,
@@ -182,6 +229,9 @@ func providerConfigureClient(d *schema.ResourceData) (interface{}, error) {
 		Endpoints:   make(map[string]interface{}),
 		X509cert:    d.Get("x509_cert_path").(string),
 		X509key:     d.Get("x509_key_path").(string),
+		DefaultTags: expandDefaultTags(d.Get("default_tags").([]interface{})),
+		MaxRetries:  d.Get("max_retries").(int),
+		MaxBackoff:  time.Duration(d.Get("retry_max_backoff").(int)) * time.Second,
 	}
 
 	endpointsSet := d.Get("endpoints").(*schema.Set)
@@ -193,9 +243,82 @@ func providerConfigureClient(d *schema.ResourceData) (interface{}, error) {
 		}
 	}
 
+	if err := loadProviderProfile(&config, d.Get("profile").(string), d.Get("config_file").(string)); err != nil {
+		return nil, err
+	}
+
+	if config.AccessKeyID == "" || config.SecretKeyID == "" || config.Region == "" {
+		return nil, fmt.Errorf("access_key_id, secret_key_id and region must be set in the provider block, " +
+			"with the OUTSCALE_ACCESSKEYID, OUTSCALE_SECRETKEYID and OUTSCALE_REGION environment variables, or in a profile of the configuration file")
+	}
+
 	return config.Client()
 }
 
+// loadProviderProfile completes the config with a profile of the configuration file. Without
+// an explicit profile, the default one is only used when the configuration file exists.
+func loadProviderProfile(config *Config, profileName, configFile string) error {
+	explicit := profileName != "" || configFile != ""
+	if profileName == "" {
+		profileName = "default"
+	}
+	if configFile == "" {
+		configFile = defaultOSCConfigFile
+	}
+
+	if !explicit {
+		path, err := expandHomeDir(configFile)
+		if err != nil {
+			return nil
+		}
+		if _, err := os.Stat(path); err != nil {
+			return nil
+		}
+	}
+
+	profile, err := loadOSCProfile(configFile, profileName)
+	if err != nil {
+		if explicit {
+			return err
+		}
+		log.Printf("[DEBUG] Ignoring the default profile: %s", err)
+		return nil
+	}
+	config.applyOSCProfile(profile)
+	return nil
+}
+
+func defaultTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Tags applied to every resource supporting tags.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"tags": {
+					Type:        schema.TypeMap,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "The tags to apply, resource-level tags override them on key collisions.",
+				},
+			},
+		},
+	}
+}
+
+func expandDefaultTags(l []interface{}) map[string]string {
+	defaultTags := make(map[string]string)
+	if len(l) == 0 || l[0] == nil {
+		return defaultTags
+	}
+
+	for k, v := range l[0].(map[string]interface{})["tags"].(map[string]interface{}) {
+		defaultTags[k] = v.(string)
+	}
+	return defaultTags
+}
+
 func endpointsSchema() *schema.Schema {
 	endpointsAttributes := make(map[string]*schema.Schema)
 
@@ -6,6 +6,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/helper/encryption"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
@@ -46,6 +47,20 @@ func resourceOutscaleAccessKey() *schema.Resource {
 				Computed: true,
 			},
 			"secret_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"pgp_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"encrypted_secret_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"pgp_key_fingerprint": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
@@ -93,6 +108,19 @@ func resourceOutscaleAccessKeyCreate(d *schema.ResourceData, meta interface{}) e
 
 	d.SetId(*res.GetAccessKey().AccessKeyId)
 
+	if v, ok := d.GetOk("pgp_key"); ok {
+		fingerprint, encrypted, err := encryptOAPISecret(v.(string), res.AccessKey.GetSecretKey(), "Outscale Access Key secret key")
+		if err != nil {
+			return err
+		}
+		if err := d.Set("pgp_key_fingerprint", fingerprint); err != nil {
+			return err
+		}
+		if err := d.Set("encrypted_secret_key", encrypted); err != nil {
+			return err
+		}
+	}
+
 	if d.Get("state").(string) != "ACTIVE" {
 		if err := updateAccessKey(conn, d.Id(), "INACTIVE"); err != nil {
 			return err
@@ -132,8 +160,11 @@ func resourceOutscaleAccessKeyRead(d *schema.ResourceData, meta interface{}) err
 	if err := d.Set("last_modification_date", accessKey.GetLastModificationDate()); err != nil {
 		return err
 	}
-	if err := d.Set("secret_key", accessKey.GetSecretKey()); err != nil {
-		return err
+	//The plaintext secret is kept out of the state when a PGP key is given
+	if _, ok := d.GetOk("pgp_key"); !ok {
+		if err := d.Set("secret_key", accessKey.GetSecretKey()); err != nil {
+			return err
+		}
 	}
 	if err := d.Set("state", accessKey.GetState()); err != nil {
 		return err
@@ -219,3 +250,14 @@ func checkDateFormat(dateFormat string) error {
 	}
 	return nil
 }
+
+// encryptOAPISecret encrypts value with the PGP public key given as a base64
+// encoded key or as a "keybase:" username, and returns the key fingerprint
+// and the base64 encoded encrypted value.
+func encryptOAPISecret(pgpKey, value, description string) (string, string, error) {
+	encryptionKey, err := encryption.RetrieveGPGKey(pgpKey)
+	if err != nil {
+		return "", "", err
+	}
+	return encryption.EncryptValue(encryptionKey, value, description)
+}
@@ -1,14 +1,18 @@
 package outscale
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
 	"fmt"
+	"io/ioutil"
 	"testing"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 	oscgo "github.com/outscale/osc-sdk-go/v2"
+	"golang.org/x/crypto/openpgp"
 )
 
 func TestAccOutscaleAccessKey_basic(t *testing.T) {
@@ -156,6 +160,74 @@ func TestAccOutscaleAccessKey_updatedExpirationDate(t *testing.T) {
 	})
 }
 
+func TestAccOutscaleAccessKey_pgpKey(t *testing.T) {
+	resourceName := "outscale_access_key.outscale_access_key"
+	_, pgpKey := testGenerateOAPIPGPKey(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOutscaleAccessKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOutscaleAccessKeyPGPKeyConfig(pgpKey),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOutscaleAccessKeyExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "encrypted_secret_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "pgp_key_fingerprint"),
+					resource.TestCheckNoResourceAttr(resourceName, "secret_key"),
+				),
+			},
+		},
+	})
+}
+
+func TestEncryptOAPISecret(t *testing.T) {
+	entity, pgpKey := testGenerateOAPIPGPKey(t)
+
+	fingerprint, encrypted, err := encryptOAPISecret(pgpKey, "secret", "test secret")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if expected := fmt.Sprintf("%x", entity.PrimaryKey.Fingerprint); fingerprint != expected {
+		t.Fatalf("expected fingerprint %q, got %q", expected, fingerprint)
+	}
+
+	ciphertext, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	md, err := openpgp.ReadMessage(bytes.NewReader(ciphertext), openpgp.EntityList{entity}, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	plaintext, err := ioutil.ReadAll(md.UnverifiedBody)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if string(plaintext) != "secret" {
+		t.Fatalf("expected decrypted value %q, got %q", "secret", plaintext)
+	}
+
+	if _, _, err := encryptOAPISecret("not a key", "secret", "test secret"); err == nil {
+		t.Fatal("expected an error for an invalid PGP key")
+	}
+}
+
+// testGenerateOAPIPGPKey returns a new PGP entity and its base64 encoded
+// public key, as expected by the pgp_key arguments.
+func testGenerateOAPIPGPKey(t *testing.T) (*openpgp.Entity, string) {
+	entity, err := openpgp.NewEntity("terraform", "", "terraform@example.com", nil)
+	if err != nil {
+		t.Fatalf("unable to generate PGP key: %s", err)
+	}
+	var buf bytes.Buffer
+	if err := entity.Serialize(&buf); err != nil {
+		t.Fatalf("unable to serialize PGP key: %s", err)
+	}
+	return entity, base64.StdEncoding.EncodeToString(buf.Bytes())
+}
+
 func testAccCheckOutscaleAccessKeyExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -218,3 +290,11 @@ func testAccOutscaleAccessKeyExpirationDateConfig(expirDate string) string {
 		}
 	`, expirDate)
 }
+
+func testAccOutscaleAccessKeyPGPKeyConfig(pgpKey string) string {
+	return fmt.Sprintf(`
+		resource "outscale_access_key" "outscale_access_key" {
+			pgp_key = "%s"
+		}
+	`, pgpKey)
+}
@@ -0,0 +1,194 @@
+package outscale
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	oscgo "github.com/outscale/osc-sdk-go/v2"
+	"github.com/terraform-providers/terraform-provider-outscale/utils"
+)
+
+// apiAccessPolicyID is the ID of the outscale_api_access_policy resource, there is only one
+// policy per account.
+const apiAccessPolicyID = "api_access_policy"
+
+func resourceOutscaleApiAccessPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOutscaleApiAccessPolicyCreate,
+		Read:   resourceOutscaleApiAccessPolicyRead,
+		Update: resourceOutscaleApiAccessPolicyUpdate,
+		Delete: resourceOutscaleApiAccessPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: resourceOutscaleApiAccessPolicyCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"max_access_key_expiration_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"require_trusted_env": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"request_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceOutscaleApiAccessPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	if err := resourceOutscaleApiAccessPolicyUpdate(d, meta); err != nil {
+		return err
+	}
+
+	d.SetId(apiAccessPolicyID)
+
+	return resourceOutscaleApiAccessPolicyRead(d, meta)
+}
+
+func resourceOutscaleApiAccessPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*OutscaleClient).OSCAPI
+
+	policy, requestID, err := readOutscaleApiAccessPolicy(conn)
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("max_access_key_expiration_seconds", policy.GetMaxAccessKeyExpirationSeconds()); err != nil {
+		return err
+	}
+	if err := d.Set("require_trusted_env", policy.GetRequireTrustedEnv()); err != nil {
+		return err
+	}
+
+	return d.Set("request_id", requestID)
+}
+
+func resourceOutscaleApiAccessPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*OutscaleClient).OSCAPI
+
+	req := oscgo.UpdateApiAccessPolicyRequest{
+		MaxAccessKeyExpirationSeconds: int64(d.Get("max_access_key_expiration_seconds").(int)),
+		RequireTrustedEnv:             d.Get("require_trusted_env").(bool),
+	}
+	if err := updateOutscaleApiAccessPolicy(conn, req); err != nil {
+		return err
+	}
+
+	if d.Id() == "" {
+		return nil
+	}
+	return resourceOutscaleApiAccessPolicyRead(d, meta)
+}
+
+// resourceOutscaleApiAccessPolicyDelete restores the default policy of the account, the policy
+// itself can't be deleted.
+func resourceOutscaleApiAccessPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*OutscaleClient).OSCAPI
+
+	return updateOutscaleApiAccessPolicy(conn, oscgo.UpdateApiAccessPolicyRequest{
+		MaxAccessKeyExpirationSeconds: 0,
+		RequireTrustedEnv:             false,
+	})
+}
+
+// resourceOutscaleApiAccessPolicyCustomizeDiff rejects at plan time the policies the API would
+// refuse: a trusted environment requires all the access keys of the account to expire.
+func resourceOutscaleApiAccessPolicyCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.Get("require_trusted_env").(bool) {
+		return nil
+	}
+
+	if diff.Get("max_access_key_expiration_seconds").(int) == 0 {
+		return fmt.Errorf("max_access_key_expiration_seconds must be greater than 0 when require_trusted_env is true")
+	}
+
+	if diff.Id() != "" && !diff.HasChange("require_trusted_env") {
+		return nil
+	}
+
+	conn := meta.(*OutscaleClient).OSCAPI
+
+	var resp oscgo.ReadAccessKeysResponse
+	var err error
+	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		resp, _, err = conn.AccessKeyApi.ReadAccessKeys(context.Background()).ReadAccessKeysRequest(oscgo.ReadAccessKeysRequest{}).Execute()
+		if err != nil {
+			if strings.Contains(fmt.Sprint(err), "RequestLimitExceeded:") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error reading the access keys: %s", utils.GetErrorResponse(err))
+	}
+
+	if ids := accessKeysWithoutExpiration(resp.GetAccessKeys()); len(ids) > 0 {
+		return fmt.Errorf("require_trusted_env can't be enabled while access keys without an expiration date exist: %s",
+			strings.Join(ids, ", "))
+	}
+	return nil
+}
+
+func accessKeysWithoutExpiration(accessKeys []oscgo.AccessKey) []string {
+	var ids []string
+	for _, accessKey := range accessKeys {
+		if accessKey.GetExpirationDate() == "" {
+			ids = append(ids, accessKey.GetAccessKeyId())
+		}
+	}
+	return ids
+}
+
+func readOutscaleApiAccessPolicy(conn *oscgo.APIClient) (*oscgo.ApiAccessPolicy, string, error) {
+	var resp oscgo.ReadApiAccessPolicyResponse
+	var err error
+	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		resp, _, err = conn.ApiAccessPolicyApi.ReadApiAccessPolicy(context.Background()).ReadApiAccessPolicyRequest(oscgo.ReadApiAccessPolicyRequest{}).Execute()
+		if err != nil {
+			if strings.Contains(fmt.Sprint(err), "RequestLimitExceeded:") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("error reading Outscale API access policy: %s", utils.GetErrorResponse(err))
+	}
+
+	policy := resp.GetApiAccessPolicy()
+	return &policy, resp.ResponseContext.GetRequestId(), nil
+}
+
+func updateOutscaleApiAccessPolicy(conn *oscgo.APIClient, req oscgo.UpdateApiAccessPolicyRequest) error {
+	var err error
+	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		_, _, err = conn.ApiAccessPolicyApi.UpdateApiAccessPolicy(context.Background()).UpdateApiAccessPolicyRequest(req).Execute()
+		if err != nil {
+			if strings.Contains(fmt.Sprint(err), "RequestLimitExceeded:") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error updating Outscale API access policy: %s", utils.GetErrorResponse(err))
+	}
+	return nil
+}
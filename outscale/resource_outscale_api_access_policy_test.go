@@ -0,0 +1,76 @@
+package outscale
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	oscgo "github.com/outscale/osc-sdk-go/v2"
+)
+
+func TestAccOutscaleApiAccessPolicy_basic(t *testing.T) {
+	resourceName := "outscale_api_access_policy.policy"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOutscaleApiAccessPolicyConfig(3600, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "max_access_key_expiration_seconds", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "require_trusted_env", "false"),
+				),
+			},
+			{
+				Config: testAccOutscaleApiAccessPolicyConfig(0, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "max_access_key_expiration_seconds", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"request_id"},
+			},
+		},
+	})
+}
+
+func TestAccOutscaleApiAccessPolicy_trustedEnvWithoutExpiration(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccOutscaleApiAccessPolicyConfig(0, true),
+				ExpectError: regexp.MustCompile("max_access_key_expiration_seconds must be greater than 0"),
+			},
+		},
+	})
+}
+
+func TestAccessKeysWithoutExpiration(t *testing.T) {
+	accessKeys := []oscgo.AccessKey{
+		{AccessKeyId: oscgo.PtrString("AK1"), ExpirationDate: oscgo.PtrString("2030-01-01T00:00:00.000+0000")},
+		{AccessKeyId: oscgo.PtrString("AK2")},
+		{AccessKeyId: oscgo.PtrString("AK3"), ExpirationDate: oscgo.PtrString("")},
+	}
+
+	expected := []string{"AK2", "AK3"}
+	if ids := accessKeysWithoutExpiration(accessKeys); !reflect.DeepEqual(ids, expected) {
+		t.Fatalf("expected %v, got %v", expected, ids)
+	}
+}
+
+func testAccOutscaleApiAccessPolicyConfig(maxExpiration int, requireTrustedEnv bool) string {
+	return fmt.Sprintf(`
+		resource "outscale_api_access_policy" "policy" {
+			max_access_key_expiration_seconds = %d
+			require_trusted_env               = %t
+		}
+	`, maxExpiration, requireTrustedEnv)
+}
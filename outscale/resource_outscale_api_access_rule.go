@@ -0,0 +1,304 @@
+package outscale
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	oscgo "github.com/outscale/osc-sdk-go/v2"
+	"github.com/terraform-providers/terraform-provider-outscale/utils"
+)
+
+func resourceOutscaleApiAccessRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOutscaleApiAccessRuleCreate,
+		Read:   resourceOutscaleApiAccessRuleRead,
+		Update: resourceOutscaleApiAccessRuleUpdate,
+		Delete: resourceOutscaleApiAccessRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ip_ranges": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				AtLeastOneOf: []string{"ip_ranges", "ca_ids"},
+			},
+			"ca_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"cns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"api_access_rule_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"request_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceOutscaleApiAccessRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*OutscaleClient).OSCAPI
+
+	req := oscgo.CreateApiAccessRuleRequest{
+		IpRanges: expandSetStringList(d.Get("ip_ranges").(*schema.Set)),
+		CaIds:    expandSetStringList(d.Get("ca_ids").(*schema.Set)),
+		Cns:      expandSetStringList(d.Get("cns").(*schema.Set)),
+	}
+	if description, ok := d.GetOk("description"); ok {
+		req.SetDescription(description.(string))
+	}
+
+	var resp oscgo.CreateApiAccessRuleResponse
+	var err error
+	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		resp, _, err = conn.ApiAccessRuleApi.CreateApiAccessRule(context.Background()).CreateApiAccessRuleRequest(req).Execute()
+		if err != nil {
+			if strings.Contains(fmt.Sprint(err), "RequestLimitExceeded:") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error creating Outscale API access rule: %s", utils.GetErrorResponse(err))
+	}
+
+	d.SetId(resp.ApiAccessRule.GetApiAccessRuleId())
+
+	return resourceOutscaleApiAccessRuleRead(d, meta)
+}
+
+func resourceOutscaleApiAccessRuleRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*OutscaleClient).OSCAPI
+
+	rules, requestID, err := readOutscaleApiAccessRules(conn, &oscgo.FiltersApiAccessRule{
+		ApiAccessRuleIds: &[]string{d.Id()},
+	})
+	if err != nil {
+		return fmt.Errorf("error reading Outscale API access rule %s: %s", d.Id(), err)
+	}
+
+	if len(rules) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	rule := rules[0]
+
+	if err := d.Set("api_access_rule_id", rule.GetApiAccessRuleId()); err != nil {
+		return err
+	}
+	if err := d.Set("ip_ranges", rule.GetIpRanges()); err != nil {
+		return err
+	}
+	if err := d.Set("ca_ids", rule.GetCaIds()); err != nil {
+		return err
+	}
+	if err := d.Set("cns", rule.GetCns()); err != nil {
+		return err
+	}
+	if err := d.Set("description", rule.GetDescription()); err != nil {
+		return err
+	}
+
+	return d.Set("request_id", requestID)
+}
+
+func resourceOutscaleApiAccessRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*OutscaleClient)
+
+	// UpdateApiAccessRule replaces all the parameters of the rule, unset ones are removed.
+	req := oscgo.UpdateApiAccessRuleRequest{
+		ApiAccessRuleId: d.Id(),
+		IpRanges:        expandSetStringList(d.Get("ip_ranges").(*schema.Set)),
+		CaIds:           expandSetStringList(d.Get("ca_ids").(*schema.Set)),
+		Cns:             expandSetStringList(d.Get("cns").(*schema.Set)),
+	}
+	req.SetDescription(d.Get("description").(string))
+
+	if d.HasChanges("ip_ranges", "ca_ids") {
+		updated := oscgo.ApiAccessRule{
+			ApiAccessRuleId: &req.ApiAccessRuleId,
+			IpRanges:        req.IpRanges,
+			CaIds:           req.CaIds,
+		}
+		if err := checkOutscaleApiAccessRulesKeepAccess(client, d.Id(), &updated); err != nil {
+			return err
+		}
+	}
+
+	var err error
+	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		_, _, err = client.OSCAPI.ApiAccessRuleApi.UpdateApiAccessRule(context.Background()).UpdateApiAccessRuleRequest(req).Execute()
+		if err != nil {
+			if strings.Contains(fmt.Sprint(err), "RequestLimitExceeded:") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error updating Outscale API access rule %s: %s", d.Id(), utils.GetErrorResponse(err))
+	}
+
+	return resourceOutscaleApiAccessRuleRead(d, meta)
+}
+
+func resourceOutscaleApiAccessRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*OutscaleClient)
+
+	if err := checkOutscaleApiAccessRulesKeepAccess(client, d.Id(), nil); err != nil {
+		return err
+	}
+
+	req := oscgo.DeleteApiAccessRuleRequest{ApiAccessRuleId: d.Id()}
+
+	var err error
+	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		_, _, err = client.OSCAPI.ApiAccessRuleApi.DeleteApiAccessRule(context.Background()).DeleteApiAccessRuleRequest(req).Execute()
+		if err != nil {
+			if strings.Contains(fmt.Sprint(err), "RequestLimitExceeded:") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error deleting Outscale API access rule %s: %s", d.Id(), utils.GetErrorResponse(err))
+	}
+
+	return nil
+}
+
+func readOutscaleApiAccessRules(conn *oscgo.APIClient, filters *oscgo.FiltersApiAccessRule) ([]oscgo.ApiAccessRule, string, error) {
+	req := oscgo.ReadApiAccessRulesRequest{Filters: filters}
+
+	var resp oscgo.ReadApiAccessRulesResponse
+	var err error
+	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		resp, _, err = conn.ApiAccessRuleApi.ReadApiAccessRules(context.Background()).ReadApiAccessRulesRequest(req).Execute()
+		if err != nil {
+			if strings.Contains(fmt.Sprint(err), "RequestLimitExceeded:") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, "", utils.GetErrorResponse(err)
+	}
+
+	return resp.GetApiAccessRules(), resp.ResponseContext.GetRequestId(), nil
+}
+
+// checkOutscaleApiAccessRulesKeepAccess makes sure the provider can still call the API once
+// the rule ruleID is replaced by updated, or deleted when updated is nil. The API denies the
+// requests which don't match any rule, so removing the rule in use would lock the account out.
+func checkOutscaleApiAccessRulesKeepAccess(client *OutscaleClient, ruleID string, updated *oscgo.ApiAccessRule) error {
+	rules, requestID, err := readOutscaleApiAccessRules(client.OSCAPI, nil)
+	if err != nil {
+		return fmt.Errorf("error reading Outscale API access rules: %s", err)
+	}
+
+	remaining := make([]oscgo.ApiAccessRule, 0, len(rules))
+	for _, rule := range rules {
+		if rule.GetApiAccessRuleId() != ruleID {
+			remaining = append(remaining, rule)
+		}
+	}
+	if updated != nil {
+		remaining = append(remaining, *updated)
+	}
+	if len(remaining) == 0 {
+		return fmt.Errorf("API access rule %s is the last one of the account, removing it would deny all the requests to the API", ruleID)
+	}
+
+	ip, err := readOutscaleCallerIPAddress(client.OSCAPI, requestID)
+	if err != nil {
+		return fmt.Errorf("unable to check that API access rule %s is not in use: %s", ruleID, err)
+	}
+	if !apiAccessRulesAllow(remaining, ip, client.ClientCertificate) {
+		return fmt.Errorf("API access rule %s is the only one allowing the requests from %s, changing or removing it would lock the provider out of the API", ruleID, ip)
+	}
+	return nil
+}
+
+// readOutscaleCallerIPAddress returns the IP address the API received the request requestID
+// from, as logged by the API. The logs can take a few seconds to be available.
+func readOutscaleCallerIPAddress(conn *oscgo.APIClient, requestID string) (net.IP, error) {
+	req := oscgo.ReadApiLogsRequest{
+		Filters: &oscgo.FiltersApiLog{RequestIds: &[]string{requestID}},
+	}
+
+	var resp oscgo.ReadApiLogsResponse
+	var err error
+	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+		resp, _, err = conn.ApiLogApi.ReadApiLogs(context.Background()).ReadApiLogsRequest(req).Execute()
+		if err != nil {
+			if strings.Contains(fmt.Sprint(err), "RequestLimitExceeded:") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		if len(resp.GetLogs()) == 0 {
+			return resource.RetryableError(fmt.Errorf("no log found yet for the request %s", requestID))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, utils.GetErrorResponse(err)
+	}
+
+	address := resp.GetLogs()[0].GetQueryIpAddress()
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return nil, fmt.Errorf("invalid IP address %q in the log of the request %s", address, requestID)
+	}
+	log.Printf("[DEBUG] The API receives the requests of the provider from %s", ip)
+	return ip, nil
+}
+
+// apiAccessRulesAllow tells if one of the rules allows the requests from ip. The rules with
+// CAs are only considered when the provider sends a client certificate, it can't tell if the
+// certificate is signed by one of these CAs.
+func apiAccessRulesAllow(rules []oscgo.ApiAccessRule, ip net.IP, clientCertificate bool) bool {
+	for _, rule := range rules {
+		if len(rule.GetCaIds()) > 0 && !clientCertificate {
+			continue
+		}
+		if len(rule.GetIpRanges()) == 0 {
+			return true
+		}
+		for _, ipRange := range rule.GetIpRanges() {
+			if _, network, err := net.ParseCIDR(ipRange); err == nil && network.Contains(ip) {
+				return true
+			}
+		}
+	}
+	return false
+}
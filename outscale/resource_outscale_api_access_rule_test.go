@@ -0,0 +1,136 @@
+package outscale
+
+import (
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	oscgo "github.com/outscale/osc-sdk-go/v2"
+)
+
+func TestAccOutscaleApiAccessRule_basic(t *testing.T) {
+	resourceName := "outscale_api_access_rule.rule"
+	caPem := testAccOutscaleCaPem(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOutscaleApiAccessRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOutscaleApiAccessRuleConfig(caPem, "192.0.2.0/24"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOutscaleApiAccessRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ip_ranges.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ca_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "description", "terraform rule"),
+				),
+			},
+			{
+				Config: testAccOutscaleApiAccessRuleConfig(caPem, "198.51.100.0/24"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOutscaleApiAccessRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ip_ranges.#", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"request_id"},
+			},
+		},
+	})
+}
+
+func TestApiAccessRulesAllow(t *testing.T) {
+	rules := []oscgo.ApiAccessRule{
+		{IpRanges: &[]string{"192.0.2.0/24"}},
+		{IpRanges: &[]string{"198.51.100.0/24"}, CaIds: &[]string{"ca-12345678"}},
+		{CaIds: &[]string{"ca-12345678"}},
+	}
+
+	cases := []struct {
+		rules             []oscgo.ApiAccessRule
+		ip                string
+		clientCertificate bool
+		expected          bool
+	}{
+		{rules, "192.0.2.10", false, true},
+		{rules, "198.51.100.10", false, false},
+		{rules, "198.51.100.10", true, true},
+		{rules[1:], "203.0.113.10", true, true},
+		{rules[:2], "203.0.113.10", true, false},
+		{nil, "192.0.2.10", false, false},
+	}
+
+	for i, tc := range cases {
+		if allowed := apiAccessRulesAllow(tc.rules, net.ParseIP(tc.ip), tc.clientCertificate); allowed != tc.expected {
+			t.Fatalf("case %d: expected %t for %s, got %t", i, tc.expected, tc.ip, allowed)
+		}
+	}
+}
+
+func testAccCheckOutscaleApiAccessRuleExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No API access rule ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*OutscaleClient).OSCAPI
+		rules, _, err := readOutscaleApiAccessRules(conn, &oscgo.FiltersApiAccessRule{
+			ApiAccessRuleIds: &[]string{rs.Primary.ID},
+		})
+		if err != nil {
+			return err
+		}
+		if len(rules) != 1 {
+			return fmt.Errorf("API access rule %s not found", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckOutscaleApiAccessRuleDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*OutscaleClient).OSCAPI
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "outscale_api_access_rule" {
+			continue
+		}
+
+		rules, _, err := readOutscaleApiAccessRules(conn, &oscgo.FiltersApiAccessRule{
+			ApiAccessRuleIds: &[]string{rs.Primary.ID},
+		})
+		if err != nil {
+			return err
+		}
+		if len(rules) != 0 {
+			return fmt.Errorf("API access rule %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccOutscaleApiAccessRuleConfig(caPem, ipRange string) string {
+	return fmt.Sprintf(`
+		resource "outscale_ca" "ca" {
+			ca_pem      = <<EOT
+%sEOT
+			description = "terraform api access rule ca"
+		}
+
+		resource "outscale_api_access_rule" "rule" {
+			ip_ranges   = ["%s"]
+			ca_ids      = ["${outscale_ca.ca.id}"]
+			cns         = ["terraform-provider-outscale"]
+			description = "terraform rule"
+		}
+	`, caPem, ipRange)
+}
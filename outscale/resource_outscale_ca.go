@@ -0,0 +1,192 @@
+package outscale
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	oscgo "github.com/outscale/osc-sdk-go/v2"
+	"github.com/terraform-providers/terraform-provider-outscale/utils"
+)
+
+func resourceOutscaleCa() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOutscaleCaCreate,
+		Read:   resourceOutscaleCaRead,
+		Update: resourceOutscaleCaUpdate,
+		Delete: resourceOutscaleCaDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ca_pem": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ExactlyOneOf:     []string{"ca_pem", "ca_pem_file"},
+				DiffSuppressFunc: suppressImportedCaPem,
+			},
+			"ca_pem_file": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressImportedCaPem,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ca_fingerprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ca_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"request_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// suppressImportedCaPem ignores the PEM of an imported CA, the API doesn't return it so it
+// can't be known when the CA is imported.
+func suppressImportedCaPem(k, old, new string, d *schema.ResourceData) bool {
+	return d.Id() != "" && old == ""
+}
+
+func resourceOutscaleCaCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*OutscaleClient).OSCAPI
+
+	caPem := d.Get("ca_pem").(string)
+	if path, ok := d.GetOk("ca_pem_file"); ok {
+		pem, err := ioutil.ReadFile(path.(string))
+		if err != nil {
+			return fmt.Errorf("error reading the CA certificate file %s: %s", path, err)
+		}
+		caPem = string(pem)
+	}
+
+	req := oscgo.CreateCaRequest{CaPem: caPem}
+	if description, ok := d.GetOk("description"); ok {
+		req.SetDescription(description.(string))
+	}
+
+	var resp oscgo.CreateCaResponse
+	var err error
+	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		resp, _, err = conn.CaApi.CreateCa(context.Background()).CreateCaRequest(req).Execute()
+		if err != nil {
+			if strings.Contains(fmt.Sprint(err), "RequestLimitExceeded:") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error creating Outscale CA: %s", utils.GetErrorResponse(err))
+	}
+
+	d.SetId(resp.Ca.GetCaId())
+
+	return resourceOutscaleCaRead(d, meta)
+}
+
+func resourceOutscaleCaRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*OutscaleClient).OSCAPI
+
+	req := oscgo.ReadCasRequest{
+		Filters: &oscgo.FiltersCa{CaIds: &[]string{d.Id()}},
+	}
+
+	var resp oscgo.ReadCasResponse
+	var err error
+	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		resp, _, err = conn.CaApi.ReadCas(context.Background()).ReadCasRequest(req).Execute()
+		if err != nil {
+			if strings.Contains(fmt.Sprint(err), "RequestLimitExceeded:") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error reading Outscale CA %s: %s", d.Id(), utils.GetErrorResponse(err))
+	}
+
+	if len(resp.GetCas()) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	ca := resp.GetCas()[0]
+
+	if err := d.Set("ca_fingerprint", ca.GetCaFingerprint()); err != nil {
+		return err
+	}
+	if err := d.Set("ca_id", ca.GetCaId()); err != nil {
+		return err
+	}
+	if err := d.Set("description", ca.GetDescription()); err != nil {
+		return err
+	}
+
+	return d.Set("request_id", resp.ResponseContext.GetRequestId())
+}
+
+func resourceOutscaleCaUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*OutscaleClient).OSCAPI
+
+	req := oscgo.UpdateCaRequest{CaId: d.Id()}
+	req.SetDescription(d.Get("description").(string))
+
+	var err error
+	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		_, _, err = conn.CaApi.UpdateCa(context.Background()).UpdateCaRequest(req).Execute()
+		if err != nil {
+			if strings.Contains(fmt.Sprint(err), "RequestLimitExceeded:") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error updating Outscale CA %s: %s", d.Id(), utils.GetErrorResponse(err))
+	}
+
+	return resourceOutscaleCaRead(d, meta)
+}
+
+func resourceOutscaleCaDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*OutscaleClient).OSCAPI
+
+	req := oscgo.DeleteCaRequest{CaId: d.Id()}
+
+	var err error
+	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		_, _, err = conn.CaApi.DeleteCa(context.Background()).DeleteCaRequest(req).Execute()
+		if err != nil {
+			if strings.Contains(fmt.Sprint(err), "RequestLimitExceeded:") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error deleting Outscale CA %s: %s", d.Id(), utils.GetErrorResponse(err))
+	}
+
+	return nil
+}
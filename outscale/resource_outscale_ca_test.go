@@ -0,0 +1,131 @@
+package outscale
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	oscgo "github.com/outscale/osc-sdk-go/v2"
+)
+
+func TestAccOutscaleCa_basic(t *testing.T) {
+	resourceName := "outscale_ca.ca"
+	caPem := testAccOutscaleCaPem(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOutscaleCaDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOutscaleCaConfig(caPem, "terraform ca"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOutscaleCaExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "ca_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "ca_fingerprint"),
+					resource.TestCheckResourceAttr(resourceName, "description", "terraform ca"),
+				),
+			},
+			{
+				Config: testAccOutscaleCaConfig(caPem, "terraform ca updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOutscaleCaExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "terraform ca updated"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ca_pem", "request_id"},
+			},
+		},
+	})
+}
+
+func testAccCheckOutscaleCaExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CA ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*OutscaleClient).OSCAPI
+		resp, _, err := conn.CaApi.ReadCas(context.Background()).ReadCasRequest(oscgo.ReadCasRequest{
+			Filters: &oscgo.FiltersCa{CaIds: &[]string{rs.Primary.ID}},
+		}).Execute()
+		if err != nil {
+			return err
+		}
+		if len(resp.GetCas()) != 1 {
+			return fmt.Errorf("CA %s not found", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckOutscaleCaDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*OutscaleClient).OSCAPI
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "outscale_ca" {
+			continue
+		}
+
+		resp, _, err := conn.CaApi.ReadCas(context.Background()).ReadCasRequest(oscgo.ReadCasRequest{
+			Filters: &oscgo.FiltersCa{CaIds: &[]string{rs.Primary.ID}},
+		}).Execute()
+		if err != nil {
+			return err
+		}
+		if len(resp.GetCas()) != 0 {
+			return fmt.Errorf("CA %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+// testAccOutscaleCaPem returns the PEM of a self-signed CA certificate.
+func testAccOutscaleCaPem(t *testing.T) string {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "terraform-provider-outscale"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func testAccOutscaleCaConfig(caPem, description string) string {
+	return fmt.Sprintf(`
+		resource "outscale_ca" "ca" {
+			ca_pem      = <<EOT
+%sEOT
+			description = "%s"
+		}
+	`, caPem, description)
+}
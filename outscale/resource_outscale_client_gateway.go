@@ -23,6 +23,7 @@ func resourceOutscaleClientGateway() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffOAPITagsAll,
 
 		Schema: map[string]*schema.Schema{
 			"bgp_asn": {
@@ -48,7 +49,8 @@ func resourceOutscaleClientGateway() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsListOAPISchema(),
+			"tags":     tagsListOAPISchema(),
+			"tags_all": tagsOAPIListSchemaComputed(),
 			"request_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -71,11 +73,8 @@ func resourceOutscaleClientGatewayCreate(d *schema.ResourceData, meta interface{
 		return err
 	}
 
-	if tags, ok := d.GetOk("tags"); ok {
-		err := assignTags(tags.(*schema.Set), *client.GetClientGateway().ClientGatewayId, conn)
-		if err != nil {
-			return err
-		}
+	if err := assignTags(d.Get("tags").(*schema.Set), *client.GetClientGateway().ClientGatewayId, meta.(*OutscaleClient)); err != nil {
+		return err
 	}
 
 	d.SetId(*client.GetClientGateway().ClientGatewayId)
@@ -120,7 +119,7 @@ func resourceOutscaleClientGatewayRead(d *schema.ResourceData, meta interface{})
 	if err := d.Set("state", clientGateway.GetState()); err != nil {
 		return err
 	}
-	if err := d.Set("tags", tagsOSCAPIToMap(clientGateway.GetTags())); err != nil {
+	if err := setOSCAPITagsAttributes(d, meta.(*OutscaleClient), clientGateway.GetTags()); err != nil {
 		return err
 	}
 
@@ -128,11 +127,11 @@ func resourceOutscaleClientGatewayRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceOutscaleClientGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*OutscaleClient).OSCAPI
+	client := meta.(*OutscaleClient)
 
 	d.Partial(true)
 
-	if err := setOSCAPITags(conn, d); err != nil {
+	if err := setOSCAPITags(client, d); err != nil {
 		return err
 	}
 
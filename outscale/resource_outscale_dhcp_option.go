@@ -21,6 +21,7 @@ func resourceOutscaleDHCPOption() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffOAPITagsAll,
 		Schema: map[string]*schema.Schema{
 			"domain_name": {
 				Type:     schema.TypeString,
@@ -54,7 +55,8 @@ func resourceOutscaleDHCPOption() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsListOAPISchema(),
+			"tags":     tagsListOAPISchema(),
+			"tags_all": tagsOAPIListSchemaComputed(),
 			"request_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -90,11 +92,8 @@ func resourceOutscaleDHCPOptionCreate(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	if tags, ok := d.GetOk("tags"); ok {
-		err := assignTags(tags.(*schema.Set), dhcp.GetDhcpOptionsSetId(), conn)
-		if err != nil {
-			return err
-		}
+	if err := assignTags(d.Get("tags").(*schema.Set), dhcp.GetDhcpOptionsSetId(), meta.(*OutscaleClient)); err != nil {
+		return err
 	}
 
 	d.SetId(dhcp.GetDhcpOptionsSetId())
@@ -133,7 +132,7 @@ func resourceOutscaleDHCPOptionRead(d *schema.ResourceData, meta interface{}) er
 	if err := d.Set("dhcp_options_set_id", dhcp.GetDhcpOptionsSetId()); err != nil {
 		return err
 	}
-	if err := d.Set("tags", tagsOSCAPIToMap(dhcp.GetTags())); err != nil {
+	if err := setOSCAPITagsAttributes(d, meta.(*OutscaleClient), dhcp.GetTags()); err != nil {
 		return err
 	}
 
@@ -141,11 +140,11 @@ func resourceOutscaleDHCPOptionRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceOutscaleDHCPOptionUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*OutscaleClient).OSCAPI
+	client := meta.(*OutscaleClient)
 
 	d.Partial(true)
 
-	if err := setOSCAPITags(conn, d); err != nil {
+	if err := setOSCAPITags(client, d); err != nil {
 		return err
 	}
 
@@ -0,0 +1,230 @@
+package outscale
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	oscgo "github.com/outscale/osc-sdk-go/v2"
+	"github.com/terraform-providers/terraform-provider-outscale/utils"
+)
+
+func resourceOutscaleDirectLink() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOutscaleDirectLinkCreate,
+		Read:   resourceOutscaleDirectLinkRead,
+		Delete: resourceOutscaleDirectLinkDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bandwidth": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"1Gbps", "10Gbps"}, false),
+			},
+			"direct_link_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"location": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"direct_link_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"request_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceOutscaleDirectLinkCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*OutscaleClient).OSCAPI
+
+	req := oscgo.CreateDirectLinkRequest{
+		Bandwidth:      d.Get("bandwidth").(string),
+		DirectLinkName: d.Get("direct_link_name").(string),
+		Location:       d.Get("location").(string),
+	}
+
+	var resp oscgo.CreateDirectLinkResponse
+	var err error
+	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		resp, _, err = conn.DirectLinkApi.CreateDirectLink(context.Background()).CreateDirectLinkRequest(req).Execute()
+		if err != nil {
+			if strings.Contains(fmt.Sprint(err), "RequestLimitExceeded:") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error creating Outscale direct link: %s", utils.GetErrorResponse(err))
+	}
+
+	directLinkID := resp.DirectLink.GetDirectLinkId()
+	d.SetId(directLinkID)
+
+	// The link stays requested until Outscale sets up the physical connection, which can take
+	// days, so only wait for the request to be registered. The interfaces wait for the link
+	// to be available before being created.
+	stateConf := &resource.StateChangeConf{
+		Target:     []string{"requested", "pending", "available"},
+		Refresh:    directLinkStateRefreshFunc(conn, directLinkID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for Outscale direct link (%s) to be requested: %s", directLinkID, err)
+	}
+
+	return resourceOutscaleDirectLinkRead(d, meta)
+}
+
+func resourceOutscaleDirectLinkRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*OutscaleClient).OSCAPI
+
+	directLinks, requestID, err := readOutscaleDirectLinks(conn, &oscgo.FiltersDirectLink{
+		DirectLinkIds: &[]string{d.Id()},
+	})
+	if err != nil {
+		return fmt.Errorf("error reading Outscale direct link %s: %s", d.Id(), err)
+	}
+
+	if len(directLinks) == 0 || directLinks[0].GetState() == "deleted" {
+		d.SetId("")
+		return nil
+	}
+
+	directLink := directLinks[0]
+
+	if err := d.Set("bandwidth", directLink.GetBandwidth()); err != nil {
+		return err
+	}
+	if err := d.Set("direct_link_name", directLink.GetDirectLinkName()); err != nil {
+		return err
+	}
+	if err := d.Set("location", directLink.GetLocation()); err != nil {
+		return err
+	}
+	if err := d.Set("account_id", directLink.GetAccountId()); err != nil {
+		return err
+	}
+	if err := d.Set("direct_link_id", directLink.GetDirectLinkId()); err != nil {
+		return err
+	}
+	if err := d.Set("region_name", directLink.GetRegionName()); err != nil {
+		return err
+	}
+	if err := d.Set("state", directLink.GetState()); err != nil {
+		return err
+	}
+
+	return d.Set("request_id", requestID)
+}
+
+func resourceOutscaleDirectLinkDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*OutscaleClient).OSCAPI
+
+	req := oscgo.DeleteDirectLinkRequest{DirectLinkId: d.Id()}
+
+	var err error
+	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		_, _, err = conn.DirectLinkApi.DeleteDirectLink(context.Background()).DeleteDirectLinkRequest(req).Execute()
+		if err != nil {
+			if strings.Contains(fmt.Sprint(err), "RequestLimitExceeded:") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error deleting Outscale direct link %s: %s", d.Id(), utils.GetErrorResponse(err))
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"requested", "pending", "available", "deleting"},
+		Target:     []string{},
+		Refresh:    directLinkStateRefreshFunc(conn, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for Outscale direct link (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// directLinkStateRefreshFunc returns the state of the direct link directLinkID, or nil once
+// the link is deleted.
+func directLinkStateRefreshFunc(conn *oscgo.APIClient, directLinkID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		directLinks, _, err := readOutscaleDirectLinks(conn, &oscgo.FiltersDirectLink{
+			DirectLinkIds: &[]string{directLinkID},
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		if len(directLinks) == 0 || directLinks[0].GetState() == "deleted" {
+			return nil, "", nil
+		}
+		return directLinks[0], directLinks[0].GetState(), nil
+	}
+}
+
+func readOutscaleDirectLinks(conn *oscgo.APIClient, filters *oscgo.FiltersDirectLink) ([]oscgo.DirectLink, string, error) {
+	req := oscgo.ReadDirectLinksRequest{Filters: filters}
+
+	var resp oscgo.ReadDirectLinksResponse
+	var err error
+	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		resp, _, err = conn.DirectLinkApi.ReadDirectLinks(context.Background()).ReadDirectLinksRequest(req).Execute()
+		if err != nil {
+			if strings.Contains(fmt.Sprint(err), "RequestLimitExceeded:") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, "", utils.GetErrorResponse(err)
+	}
+
+	return resp.GetDirectLinks(), resp.ResponseContext.GetRequestId(), nil
+}
@@ -0,0 +1,309 @@
+package outscale
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	oscgo "github.com/outscale/osc-sdk-go/v2"
+	"github.com/terraform-providers/terraform-provider-outscale/utils"
+)
+
+func resourceOutscaleDirectLinkInterface() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOutscaleDirectLinkInterfaceCreate,
+		Read:   resourceOutscaleDirectLinkInterfaceRead,
+		Delete: resourceOutscaleDirectLinkInterfaceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"direct_link_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"direct_link_interface_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"virtual_gateway_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vlan": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 4094),
+			},
+			"bgp_asn": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"bgp_key": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Computed:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"client_private_ip": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"outscale_private_ip": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"direct_link_interface_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"interface_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"location": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"request_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceOutscaleDirectLinkInterfaceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*OutscaleClient).OSCAPI
+
+	directLinkID := d.Get("direct_link_id").(string)
+
+	// An interface can only be created on an available link, which is not the case until the
+	// physical connection is established.
+	linkConf := &resource.StateChangeConf{
+		Pending:    []string{"requested", "pending"},
+		Target:     []string{"available"},
+		Refresh:    directLinkStateRefreshFunc(conn, directLinkID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		MinTimeout: 10 * time.Second,
+	}
+	if _, err := linkConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for Outscale direct link (%s) to become available: %s", directLinkID, err)
+	}
+
+	directLinkInterface := oscgo.DirectLinkInterface{
+		BgpAsn:                  int32(d.Get("bgp_asn").(int)),
+		DirectLinkInterfaceName: d.Get("direct_link_interface_name").(string),
+		VirtualGatewayId:        d.Get("virtual_gateway_id").(string),
+		Vlan:                    int32(d.Get("vlan").(int)),
+	}
+	if bgpKey, ok := d.GetOk("bgp_key"); ok {
+		directLinkInterface.SetBgpKey(bgpKey.(string))
+	}
+	if clientPrivateIP, ok := d.GetOk("client_private_ip"); ok {
+		directLinkInterface.SetClientPrivateIp(clientPrivateIP.(string))
+	}
+	if outscalePrivateIP, ok := d.GetOk("outscale_private_ip"); ok {
+		directLinkInterface.SetOutscalePrivateIp(outscalePrivateIP.(string))
+	}
+
+	req := oscgo.CreateDirectLinkInterfaceRequest{
+		DirectLinkId:        directLinkID,
+		DirectLinkInterface: directLinkInterface,
+	}
+
+	var resp oscgo.CreateDirectLinkInterfaceResponse
+	var err error
+	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		resp, _, err = conn.DirectLinkInterfaceApi.CreateDirectLinkInterface(context.Background()).CreateDirectLinkInterfaceRequest(req).Execute()
+		if err != nil {
+			if strings.Contains(fmt.Sprint(err), "RequestLimitExceeded:") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error creating Outscale direct link interface: %s", utils.GetErrorResponse(err))
+	}
+
+	directLinkInterfaceID := resp.DirectLinkInterface.GetDirectLinkInterfaceId()
+	d.SetId(directLinkInterfaceID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"pending", "confirming"},
+		Target:     []string{"available"},
+		Refresh:    directLinkInterfaceStateRefreshFunc(conn, directLinkInterfaceID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for Outscale direct link interface (%s) to become available: %s", directLinkInterfaceID, err)
+	}
+
+	return resourceOutscaleDirectLinkInterfaceRead(d, meta)
+}
+
+func resourceOutscaleDirectLinkInterfaceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*OutscaleClient).OSCAPI
+
+	directLinkInterfaces, requestID, err := readOutscaleDirectLinkInterfaces(conn, &oscgo.FiltersDirectLinkInterface{
+		DirectLinkInterfaceIds: &[]string{d.Id()},
+	})
+	if err != nil {
+		return fmt.Errorf("error reading Outscale direct link interface %s: %s", d.Id(), err)
+	}
+
+	if len(directLinkInterfaces) == 0 || directLinkInterfaces[0].GetState() == "deleted" {
+		d.SetId("")
+		return nil
+	}
+
+	directLinkInterface := directLinkInterfaces[0]
+
+	if err := d.Set("direct_link_id", directLinkInterface.GetDirectLinkId()); err != nil {
+		return err
+	}
+	if err := d.Set("direct_link_interface_name", directLinkInterface.GetDirectLinkInterfaceName()); err != nil {
+		return err
+	}
+	if err := d.Set("virtual_gateway_id", directLinkInterface.GetVirtualGatewayId()); err != nil {
+		return err
+	}
+	if err := d.Set("vlan", directLinkInterface.GetVlan()); err != nil {
+		return err
+	}
+	if err := d.Set("bgp_asn", directLinkInterface.GetBgpAsn()); err != nil {
+		return err
+	}
+	if err := d.Set("bgp_key", directLinkInterface.GetBgpKey()); err != nil {
+		return err
+	}
+	if err := d.Set("client_private_ip", directLinkInterface.GetClientPrivateIp()); err != nil {
+		return err
+	}
+	if err := d.Set("outscale_private_ip", directLinkInterface.GetOutscalePrivateIp()); err != nil {
+		return err
+	}
+	if err := d.Set("account_id", directLinkInterface.GetAccountId()); err != nil {
+		return err
+	}
+	if err := d.Set("direct_link_interface_id", directLinkInterface.GetDirectLinkInterfaceId()); err != nil {
+		return err
+	}
+	if err := d.Set("interface_type", directLinkInterface.GetInterfaceType()); err != nil {
+		return err
+	}
+	if err := d.Set("location", directLinkInterface.GetLocation()); err != nil {
+		return err
+	}
+	if err := d.Set("state", directLinkInterface.GetState()); err != nil {
+		return err
+	}
+
+	return d.Set("request_id", requestID)
+}
+
+func resourceOutscaleDirectLinkInterfaceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*OutscaleClient).OSCAPI
+
+	req := oscgo.DeleteDirectLinkInterfaceRequest{DirectLinkInterfaceId: d.Id()}
+
+	var err error
+	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		_, _, err = conn.DirectLinkInterfaceApi.DeleteDirectLinkInterface(context.Background()).DeleteDirectLinkInterfaceRequest(req).Execute()
+		if err != nil {
+			if strings.Contains(fmt.Sprint(err), "RequestLimitExceeded:") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error deleting Outscale direct link interface %s: %s", d.Id(), utils.GetErrorResponse(err))
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"pending", "confirming", "available", "deleting"},
+		Target:     []string{},
+		Refresh:    directLinkInterfaceStateRefreshFunc(conn, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for Outscale direct link interface (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// directLinkInterfaceStateRefreshFunc returns the state of the direct link interface
+// directLinkInterfaceID, or nil once the interface is deleted.
+func directLinkInterfaceStateRefreshFunc(conn *oscgo.APIClient, directLinkInterfaceID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		directLinkInterfaces, _, err := readOutscaleDirectLinkInterfaces(conn, &oscgo.FiltersDirectLinkInterface{
+			DirectLinkInterfaceIds: &[]string{directLinkInterfaceID},
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		if len(directLinkInterfaces) == 0 || directLinkInterfaces[0].GetState() == "deleted" {
+			return nil, "", nil
+		}
+		return directLinkInterfaces[0], directLinkInterfaces[0].GetState(), nil
+	}
+}
+
+func readOutscaleDirectLinkInterfaces(conn *oscgo.APIClient, filters *oscgo.FiltersDirectLinkInterface) ([]oscgo.DirectLinkInterfaces, string, error) {
+	req := oscgo.ReadDirectLinkInterfacesRequest{Filters: filters}
+
+	var resp oscgo.ReadDirectLinkInterfacesResponse
+	var err error
+	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		resp, _, err = conn.DirectLinkInterfaceApi.ReadDirectLinkInterfaces(context.Background()).ReadDirectLinkInterfacesRequest(req).Execute()
+		if err != nil {
+			if strings.Contains(fmt.Sprint(err), "RequestLimitExceeded:") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, "", utils.GetErrorResponse(err)
+	}
+
+	return resp.GetDirectLinkInterfaces(), resp.ResponseContext.GetRequestId(), nil
+}
@@ -0,0 +1,86 @@
+package outscale
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	oscgo "github.com/outscale/osc-sdk-go/v2"
+)
+
+// TestAccOutscaleDirectLinkInterface_basic needs a direct link with an established physical
+// connection, its ID is read from OUTSCALE_DIRECT_LINK_ID.
+func TestAccOutscaleDirectLinkInterface_basic(t *testing.T) {
+	directLinkID := os.Getenv("OUTSCALE_DIRECT_LINK_ID")
+	if directLinkID == "" {
+		t.Skip("OUTSCALE_DIRECT_LINK_ID must be set to an available direct link")
+	}
+
+	resourceName := "outscale_direct_link_interface.interface"
+	name := fmt.Sprintf("terraform-dl-interface-%d", acctest.RandInt())
+	vlan := acctest.RandIntRange(100, 4000)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOutscaleDirectLinkInterfaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOutscaleDirectLinkInterfaceConfig(directLinkID, name, vlan),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "direct_link_interface_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "virtual_gateway_id", "outscale_virtual_gateway.gateway", "id"),
+					resource.TestCheckResourceAttr(resourceName, "direct_link_id", directLinkID),
+					resource.TestCheckResourceAttr(resourceName, "vlan", fmt.Sprint(vlan)),
+					resource.TestCheckResourceAttr(resourceName, "state", "available"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"request_id"},
+			},
+		},
+	})
+}
+
+func testAccCheckOutscaleDirectLinkInterfaceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*OutscaleClient).OSCAPI
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "outscale_direct_link_interface" {
+			continue
+		}
+
+		directLinkInterfaces, _, err := readOutscaleDirectLinkInterfaces(conn, &oscgo.FiltersDirectLinkInterface{
+			DirectLinkInterfaceIds: &[]string{rs.Primary.ID},
+		})
+		if err != nil {
+			return err
+		}
+		if len(directLinkInterfaces) != 0 && directLinkInterfaces[0].GetState() != "deleted" {
+			return fmt.Errorf("direct link interface %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccOutscaleDirectLinkInterfaceConfig(directLinkID, name string, vlan int) string {
+	return fmt.Sprintf(`
+		resource "outscale_virtual_gateway" "gateway" {
+			connection_type = "ipsec.1"
+		}
+
+		resource "outscale_direct_link_interface" "interface" {
+			direct_link_id             = "%s"
+			direct_link_interface_name = "%s"
+			virtual_gateway_id         = "${outscale_virtual_gateway.gateway.id}"
+			vlan                       = %d
+			bgp_asn                    = 65000
+		}
+	`, directLinkID, name, vlan)
+}
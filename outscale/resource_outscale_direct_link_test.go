@@ -0,0 +1,97 @@
+package outscale
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	oscgo "github.com/outscale/osc-sdk-go/v2"
+)
+
+func TestAccOutscaleDirectLink_basic(t *testing.T) {
+	resourceName := "outscale_direct_link.link"
+	name := fmt.Sprintf("terraform-direct-link-%d", acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOutscaleDirectLinkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOutscaleDirectLinkConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOutscaleDirectLinkExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "direct_link_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "state"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth", "1Gbps"),
+					resource.TestCheckResourceAttr(resourceName, "direct_link_name", name),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"request_id"},
+			},
+		},
+	})
+}
+
+func testAccCheckOutscaleDirectLinkExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No direct link ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*OutscaleClient).OSCAPI
+		directLinks, _, err := readOutscaleDirectLinks(conn, &oscgo.FiltersDirectLink{
+			DirectLinkIds: &[]string{rs.Primary.ID},
+		})
+		if err != nil {
+			return err
+		}
+		if len(directLinks) != 1 {
+			return fmt.Errorf("direct link %s not found", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckOutscaleDirectLinkDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*OutscaleClient).OSCAPI
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "outscale_direct_link" {
+			continue
+		}
+
+		directLinks, _, err := readOutscaleDirectLinks(conn, &oscgo.FiltersDirectLink{
+			DirectLinkIds: &[]string{rs.Primary.ID},
+		})
+		if err != nil {
+			return err
+		}
+		if len(directLinks) != 0 && directLinks[0].GetState() != "deleted" {
+			return fmt.Errorf("direct link %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccOutscaleDirectLinkConfig(name string) string {
+	return fmt.Sprintf(`
+		data "outscale_locations" "locations" {}
+
+		resource "outscale_direct_link" "link" {
+			bandwidth        = "1Gbps"
+			direct_link_name = "%s"
+			location         = "${data.outscale_locations.locations.locations.0.code}"
+		}
+	`, name)
+}
@@ -35,6 +35,7 @@ func resourceOutscaleOAPIImage() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffOAPITagsAll,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(40 * time.Minute),
@@ -175,7 +176,8 @@ func resourceOutscaleOAPIImage() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsListOAPISchema(),
+			"tags":     tagsListOAPISchema(),
+			"tags_all": tagsOAPIListSchemaComputed(),
 		},
 	}
 }
@@ -248,11 +250,8 @@ func resourceOAPIImageCreate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error waiting for OMI (%s) to be ready: %v", *image.ImageId, err)
 	}
 
-	if tags, ok := d.GetOk("tags"); ok {
-		err := assignTags(tags.(*schema.Set), image.GetImageId(), conn)
-		if err != nil {
-			return err
-		}
+	if err := assignTags(d.Get("tags").(*schema.Set), image.GetImageId(), meta.(*OutscaleClient)); err != nil {
+		return err
 	}
 
 	d.SetId(*image.ImageId)
@@ -332,7 +331,7 @@ func resourceOAPIImageRead(d *schema.ResourceData, meta interface{}) error {
 		if err := set("permissions_to_launch", setResourcePermissions(*image.PermissionsToLaunch)); err != nil {
 			return err
 		}
-		if err := d.Set("tags", tagsOSCAPIToMap(image.GetTags())); err != nil {
+		if err := setOSCAPITagsAttributes(d, meta.(*OutscaleClient), image.GetTags()); err != nil {
 			fmt.Printf("[WARN] ERROR TAGS PROBLEME (%s)", err)
 		}
 
@@ -350,10 +349,10 @@ func setResourcePermissions(por oscgo.PermissionsOnResource) []map[string]interf
 }
 
 func resourceOAPIImageUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*OutscaleClient).OSCAPI
+	client := meta.(*OutscaleClient)
 
 	d.Partial(true)
-	if err := setOSCAPITags(conn, d); err != nil {
+	if err := setOSCAPITags(client, d); err != nil {
 		return err
 	}
 	d.SetPartial("tags")
@@ -23,6 +23,7 @@ func resourceOutscaleOAPIIMageExportTask() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffOAPITagsAll,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(40 * time.Minute),
@@ -62,8 +63,9 @@ func resourceOutscaleOAPIIMageExportTask() *schema.Resource {
 										Required: true,
 									},
 									"secret_key": {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:      schema.TypeString,
+										Required:  true,
+										Sensitive: true,
 									},
 								},
 							},
@@ -102,7 +104,8 @@ func resourceOutscaleOAPIIMageExportTask() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsListOAPISchema(),
+			"tags":     tagsListOAPISchema(),
+			"tags_all": tagsOAPIListSchemaComputed(),
 		},
 	}
 }
@@ -177,7 +180,7 @@ func resourceOAPIImageExportTaskCreate(d *schema.ResourceData, meta interface{})
 	id := resp.ImageExportTask.GetTaskId()
 	d.SetId(id)
 	if d.IsNewResource() {
-		if err := setOSCAPITags(conn, d); err != nil {
+		if err := setOSCAPITags(meta.(*OutscaleClient), d); err != nil {
 			return err
 		}
 		d.SetPartial("tags")
@@ -270,7 +273,7 @@ func resourceOAPIImageExportTaskRead(d *schema.ResourceData, meta interface{}) e
 	if err = d.Set("osu_export", exp); err != nil {
 		return err
 	}
-	if err = d.Set("tags", tagsOSCAPIToMap(v.GetTags())); err != nil {
+	if err = setOSCAPITagsAttributes(d, meta.(*OutscaleClient), v.GetTags()); err != nil {
 		return err
 	}
 
@@ -278,10 +281,10 @@ func resourceOAPIImageExportTaskRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceOAPIImageExportTaskUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*OutscaleClient).OSCAPI
+	client := meta.(*OutscaleClient)
 
 	d.Partial(true)
-	if err := setOSCAPITags(conn, d); err != nil {
+	if err := setOSCAPITags(client, d); err != nil {
 		return err
 	}
 	d.SetPartial("tags")
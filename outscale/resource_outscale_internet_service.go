@@ -23,6 +23,7 @@ func resourceOutscaleOAPIInternetService() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffOAPITagsAll,
 
 		Schema: map[string]*schema.Schema{
 			"state": {
@@ -41,7 +42,8 @@ func resourceOutscaleOAPIInternetService() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsListOAPISchema(),
+			"tags":     tagsListOAPISchema(),
+			"tags_all": tagsOAPIListSchemaComputed(),
 		},
 	}
 }
@@ -54,11 +56,8 @@ func resourceOutscaleOAPIInternetServiceCreate(d *schema.ResourceData, meta inte
 		return fmt.Errorf("[DEBUG] Error creating Internet Service: %s", utils.GetErrorResponse(err))
 	}
 
-	if tags, ok := d.GetOk("tags"); ok {
-		err := assignTags(tags.(*schema.Set), resp.InternetService.GetInternetServiceId(), conn)
-		if err != nil {
-			return err
-		}
+	if err := assignTags(d.Get("tags").(*schema.Set), resp.InternetService.GetInternetServiceId(), meta.(*OutscaleClient)); err != nil {
+		return err
 	}
 
 	d.SetId(resp.InternetService.GetInternetServiceId())
@@ -116,15 +115,15 @@ func resourceOutscaleOAPIInternetServiceRead(d *schema.ResourceData, meta interf
 		return err
 	}
 
-	return d.Set("tags", tagsOSCAPIToMap(resp.GetInternetServices()[0].GetTags()))
+	return setOSCAPITagsAttributes(d, meta.(*OutscaleClient), resp.GetInternetServices()[0].GetTags())
 }
 
 func resourceOutscaleOAPIInternetServiceUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*OutscaleClient).OSCAPI
+	client := meta.(*OutscaleClient)
 
 	d.Partial(true)
 
-	if err := setOSCAPITags(conn, d); err != nil {
+	if err := setOSCAPITags(client, d); err != nil {
 		return err
 	}
 
@@ -82,7 +82,18 @@ func resourceOAPIKeyPairCreate(d *schema.ResourceData, meta interface{}) error {
 
 	//Set private key in creation
 	if resp.Keypair.GetPrivateKey() != "" {
-		if err := d.Set("private_key", resp.Keypair.GetPrivateKey()); err != nil {
+		if v, ok := d.GetOk("pgp_key"); ok {
+			fingerprint, encrypted, err := encryptOAPISecret(v.(string), resp.Keypair.GetPrivateKey(), "Outscale Keypair private key")
+			if err != nil {
+				return err
+			}
+			if err := d.Set("pgp_key_fingerprint", fingerprint); err != nil {
+				return err
+			}
+			if err := d.Set("encrypted_private_key", encrypted); err != nil {
+				return err
+			}
+		} else if err := d.Set("private_key", resp.Keypair.GetPrivateKey()); err != nil {
 			return err
 		}
 	}
@@ -171,6 +182,15 @@ func getOAPIKeyPairSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"private_key": {
+			Type:      schema.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+		"encrypted_private_key": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"pgp_key_fingerprint": {
 			Type:     schema.TypeString,
 			Computed: true,
 		},
@@ -184,6 +204,11 @@ func getOAPIKeyPairSchema() map[string]*schema.Schema {
 			Optional: true,
 			Computed: true,
 		},
+		"pgp_key": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ForceNew: true,
+		},
 		"request_id": {
 			Type:     schema.TypeString,
 			Computed: true,
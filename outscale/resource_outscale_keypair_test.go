@@ -85,6 +85,29 @@ func TestAccOutscaleOAPIKeyPair_generatedName(t *testing.T) {
 	})
 }
 
+func TestAccOutscaleOAPIKeyPair_pgpKey(t *testing.T) {
+	var conf oscgo.Keypair
+	_, pgpKey := testGenerateOAPIPGPKey(t)
+
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOutscaleOAPIKeyPairDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOutscaleOAPIKeyPairConfigPGPKey(rInt, pgpKey),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOutscaleOAPIKeyPairExists("outscale_keypair.a_key_pair", &conf),
+					resource.TestCheckResourceAttrSet("outscale_keypair.a_key_pair", "encrypted_private_key"),
+					resource.TestCheckResourceAttrSet("outscale_keypair.a_key_pair", "pgp_key_fingerprint"),
+					resource.TestCheckNoResourceAttr("outscale_keypair.a_key_pair", "private_key"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckOutscaleOAPIKeyPairDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*OutscaleClient)
 
@@ -198,6 +221,15 @@ func testAccOutscaleOAPIKeyPairConfigRetrieveName(r int) string {
 	`, r)
 }
 
+func testAccOutscaleOAPIKeyPairConfigPGPKey(r int, pgpKey string) string {
+	return fmt.Sprintf(`
+		resource "outscale_keypair" "a_key_pair" {
+			keypair_name = "tf-acc-key-pair-%d"
+			pgp_key      = "%s"
+		}
+	`, r, pgpKey)
+}
+
 const testAccOutscaleOAPIKeyPairConfigGeneratedName = `
 	resource "outscale_keypair" "a_key_pair" {
 		public_key = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQD3F6tyPEFEzV0LX3X8BsXdMsQz1x2cEikKDEY0aIj41qgxMCP/iteneqXSIFZBp5vizPvaoIR3Um9xK7PGoW8giupGn+EPuxIA4cDM4vzOqOkiMPhz5XK0whEjkVzTo4+S0puvDZuwIsdiW9mxhJc7tgBNL0cYlWSYVkz4G/fslNfRPW5mYAM49f4fhtxPb5ok4Q2Lg9dPKVHO/Bgeu5woMc7RY0p1ej6D4CKFE6lymSDJpW0YHX/wqE9+cfEauh7xZcG0q9t2ta6F6fmX0agvpFyZo8aFbXeUBr7osSCJNgvavWbM/06niWrOvYX2xwWdhXmXSrbX8ZbabVohBK41 phodgson@thoughtworks.com"
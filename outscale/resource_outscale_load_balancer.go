@@ -43,6 +43,7 @@ func resourceOutscaleOAPILoadBalancer() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffOAPITagsAll,
 
 		Schema: map[string]*schema.Schema{
 			"subregion_names": {
@@ -76,7 +77,8 @@ func resourceOutscaleOAPILoadBalancer() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-			"tags": tagsListOAPISchema2(false),
+			"tags":     tagsListOAPISchema2(false),
+			"tags_all": tagsOAPIListSchemaComputed(),
 
 			"dns_name": {
 				Type:     schema.TypeString,
@@ -163,6 +165,11 @@ func resourceOutscaleOAPILoadBalancer() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"public_ip": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
 			"application_sticky_cookie_policies": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -184,6 +191,10 @@ func resourceOutscaleOAPILoadBalancer() *schema.Resource {
 				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"cookie_expiration_period": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
 						"policy_name": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -242,6 +253,24 @@ func flattenOAPIListeners(list *[]oscgo.Listener) []map[string]interface{} {
 	return result
 }
 
+// filterOAPIListenersByPort keeps the listeners whose load balancer port is
+// used by one of the known listeners, so that the listeners managed by
+// outscale_load_balancer_listener resources are not seen as drift.
+func filterOAPIListenersByPort(listeners []map[string]interface{}, known []interface{}) []map[string]interface{} {
+	ports := make(map[int]bool, len(known))
+	for _, k := range known {
+		ports[k.(map[string]interface{})["load_balancer_port"].(int)] = true
+	}
+
+	result := make([]map[string]interface{}, 0, len(listeners))
+	for _, l := range listeners {
+		if ports[l["load_balancer_port"].(int)] {
+			result = append(result, l)
+		}
+	}
+	return result
+}
+
 func expandListeners(configured []interface{}) ([]*oscgo.Listener, error) {
 	listeners := make([]*oscgo.Listener, 0, len(configured))
 
@@ -392,8 +421,7 @@ func resourceOutscaleOAPILoadBalancerCreate_(d *schema.ResourceData, meta interf
 		req.LoadBalancerName = v.(string)
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
-		r := tagsFromSliceMap(v.(*schema.Set))
+	if r := mergeDefaultTags(meta.(*OutscaleClient).DefaultTags, tagsFromSliceMap(d.Get("tags").(*schema.Set))); len(r) > 0 {
 		req.Tags = &r
 	}
 
@@ -406,6 +434,10 @@ func resourceOutscaleOAPILoadBalancerCreate_(d *schema.ResourceData, meta interf
 		req.SecurityGroups = expandSetStringList(v.(*schema.Set))
 	}
 
+	if v, ok := d.GetOk("public_ip"); ok {
+		req.SetPublicIp(v.(string))
+	}
+
 	v_sb, sb_ok := d.GetOk("subnets")
 	if sb_ok {
 		req.Subnets = expandStringList(v_sb.([]interface{}))
@@ -517,25 +549,18 @@ func resourceOutscaleOAPILoadBalancerRead(d *schema.ResourceData, meta interface
 	d.Set("access_log", flattenOAPIAccessLog(lb.AccessLog))
 
 	d.Set("backend_vm_ids", flattenStringList(lb.BackendVmIds))
-	if err := d.Set("listeners", flattenOAPIListeners(lb.Listeners)); err != nil {
+	listeners := flattenOAPIListeners(lb.Listeners)
+	if l := d.Get("listeners").(*schema.Set); l.Len() > 0 {
+		listeners = filterOAPIListenersByPort(listeners, l.List())
+	}
+	if err := d.Set("listeners", listeners); err != nil {
 		log.Printf("[DEBUG] out err %v", err)
 		return err
 	}
 	d.Set("load_balancer_name", lb.LoadBalancerName)
 
-	if lb.Tags != nil {
-		ta := make([]map[string]interface{}, len(*lb.Tags))
-		for k1, v1 := range *lb.Tags {
-			t := make(map[string]interface{})
-			t["key"] = v1.Key
-			t["value"] = v1.Value
-			ta[k1] = t
-		}
-
-		d.Set("tags", ta)
-	} else {
-		d.Set("tags", make([]map[string]interface{}, 0))
-
+	if err := setOSCAPITagsAttributes(d, meta.(*OutscaleClient), lb.GetTags()); err != nil {
+		return err
 	}
 
 	if lb.ApplicationStickyCookiePolicies != nil {
@@ -554,6 +579,7 @@ func resourceOutscaleOAPILoadBalancerRead(d *schema.ResourceData, meta interface
 			len(*lb.LoadBalancerStickyCookiePolicies))
 		for k, v := range *lb.LoadBalancerStickyCookiePolicies {
 			a := make(map[string]interface{})
+			a["cookie_expiration_period"] = v.GetCookieExpirationPeriod()
 			a["policy_name"] = v.PolicyName
 			lbc[k] = a
 		}
@@ -574,6 +600,7 @@ func resourceOutscaleOAPILoadBalancerRead(d *schema.ResourceData, meta interface
 	d.Set("source_security_group", ssg)
 	d.Set("subnets", flattenStringList(lb.Subnets))
 	d.Set("net_id", lb.NetId)
+	d.Set("public_ip", lb.GetPublicIp())
 
 	return nil
 }
@@ -609,15 +636,43 @@ func resourceOutscaleOAPILoadBalancerUpdate(d *schema.ResourceData, meta interfa
 		d.SetPartial("security_groups")
 	}
 
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChange("public_ip") {
+		req := oscgo.UpdateLoadBalancerRequest{
+			LoadBalancerName: d.Id(),
+		}
+		req.SetPublicIp(d.Get("public_ip").(string))
+
+		var err error
+		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+			_, _, err = conn.LoadBalancerApi.UpdateLoadBalancer(
+				context.Background()).UpdateLoadBalancerRequest(req).Execute()
+			if err != nil {
+				if strings.Contains(err.Error(), "Throttling:") {
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		})
+
+		if err != nil {
+			return fmt.Errorf("Failure updating PublicIp: %s", err)
+		}
+		d.SetPartial("public_ip")
+	}
+
+	if d.HasChange("tags") || d.HasChange("tags_all") {
+		oraw, _ := d.GetChange("tags_all")
 		o := oraw.(*schema.Set)
-		n := nraw.(*schema.Set)
-		create := tagsFromSliceMap(n)
+		if o.Len() == 0 {
+			oraw, _ = d.GetChange("tags")
+			o = oraw.(*schema.Set)
+		}
+		n := mergeDefaultTags(meta.(*OutscaleClient).DefaultTags, tagsFromSliceMap(d.Get("tags").(*schema.Set)))
+		create, removed := diffOSCAPITags(tagsFromSliceMap(o), n)
 		var remove []oscgo.ResourceLoadBalancerTag
-		for _, t := range o.List() {
-			tag := t.(map[string]interface{})
-			s := tag["key"].(string)
+		for _, t := range removed {
+			s := t.Key
 			remove = append(remove,
 				oscgo.ResourceLoadBalancerTag{
 					Key: &s,
@@ -681,8 +736,8 @@ func resourceOutscaleOAPILoadBalancerUpdate(d *schema.ResourceData, meta interfa
 		ns := n.(*schema.Set).List()
 
 		log.Printf("[DEBUG] it change !: %v %v", os, ns)
-		remove, _ := expandListeners(os)
-		add, _ := expandListenerForCreation(ns)
+		remove, _ := expandListeners(o.(*schema.Set).Difference(n.(*schema.Set)).List())
+		add, _ := expandListenerForCreation(n.(*schema.Set).Difference(o.(*schema.Set)).List())
 
 		if len(remove) > 0 {
 			ports := make([]int32, 0, len(remove))
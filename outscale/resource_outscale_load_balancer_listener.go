@@ -0,0 +1,291 @@
+package outscale
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	oscgo "github.com/outscale/osc-sdk-go/v2"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+var lbListenerProtocols = []string{"HTTP", "HTTPS", "TCP", "SSL"}
+
+func resourceOutscaleOAPILoadBalancerListener() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOutscaleOAPILoadBalancerListenerCreate,
+		Read:   resourceOutscaleOAPILoadBalancerListenerRead,
+		Update: resourceOutscaleOAPILoadBalancerListenerUpdate,
+		Delete: resourceOutscaleOAPILoadBalancerListenerDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceOutscaleOAPILoadBalancerListenerImportState,
+		},
+		CustomizeDiff: customizeDiffOAPILoadBalancerListener,
+
+		Schema: map[string]*schema.Schema{
+			"load_balancer_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"load_balancer_port": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 65535),
+			},
+			"load_balancer_protocol": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(lbListenerProtocols, false),
+			},
+			"backend_port": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 65535),
+			},
+			"backend_protocol": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(lbListenerProtocols, false),
+			},
+			"server_certificate_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"policy_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"request_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// customizeDiffOAPILoadBalancerListener replaces the listener when its server certificate is
+// removed, as the API can't remove the certificate of an existing listener.
+func customizeDiffOAPILoadBalancerListener(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("server_certificate_id") {
+		return nil
+	}
+	if o, n := diff.GetChange("server_certificate_id"); o.(string) != "" && n.(string) == "" {
+		return diff.ForceNew("server_certificate_id")
+	}
+	return nil
+}
+
+func resourceOutscaleOAPILoadBalancerListenerCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*OutscaleClient).OSCAPI
+
+	lbName := d.Get("load_balancer_name").(string)
+	port := d.Get("load_balancer_port").(int)
+
+	// expandListenerForCreation also checks that a server certificate is only
+	// given for HTTPS or SSL listeners.
+	listeners, err := expandListenerForCreation([]interface{}{map[string]interface{}{
+		"backend_port":           d.Get("backend_port"),
+		"backend_protocol":       d.Get("backend_protocol"),
+		"load_balancer_port":     port,
+		"load_balancer_protocol": d.Get("load_balancer_protocol"),
+		"server_certificate_id":  d.Get("server_certificate_id"),
+	}})
+	if err != nil {
+		return err
+	}
+
+	req := oscgo.CreateLoadBalancerListenersRequest{
+		LoadBalancerName: lbName,
+		Listeners:        listeners,
+	}
+
+	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		_, _, err := conn.ListenerApi.CreateLoadBalancerListeners(
+			context.Background()).CreateLoadBalancerListenersRequest(req).Execute()
+		if err != nil {
+			if strings.Contains(fmt.Sprint(err), "CertificateNotFound") {
+				return resource.RetryableError(err)
+			}
+			if strings.Contains(fmt.Sprint(err), "Throttling") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Failure creating Load Balancer listener: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%d", lbName, port))
+
+	if v, ok := d.GetOk("policy_names"); ok {
+		req := oscgo.UpdateLoadBalancerRequest{
+			LoadBalancerName: lbName,
+			PolicyNames:      expandSetStringList(v.(*schema.Set)),
+		}
+		req.SetLoadBalancerPort(int32(port))
+		if err := updateOAPILoadBalancer(conn, req); err != nil {
+			return fmt.Errorf("Failure setting policy_names of Load Balancer listener %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceOutscaleOAPILoadBalancerListenerRead(d, meta)
+}
+
+func resourceOutscaleOAPILoadBalancerListenerRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*OutscaleClient).OSCAPI
+
+	lbName := d.Get("load_balancer_name").(string)
+	port := d.Get("load_balancer_port").(int)
+
+	lb, _, err := readResourceLb(conn, lbName)
+	if err != nil {
+		if isOAPILBUNotFound(err) {
+			log.Printf("[WARN] Load Balancer %s not found, removing listener from state", lbName)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	for _, l := range lb.GetListeners() {
+		if int(l.GetLoadBalancerPort()) != port {
+			continue
+		}
+		if err := d.Set("load_balancer_protocol", l.GetLoadBalancerProtocol()); err != nil {
+			return err
+		}
+		if err := d.Set("backend_port", int(l.GetBackendPort())); err != nil {
+			return err
+		}
+		if err := d.Set("backend_protocol", l.GetBackendProtocol()); err != nil {
+			return err
+		}
+		if err := d.Set("server_certificate_id", l.GetServerCertificateId()); err != nil {
+			return err
+		}
+		return d.Set("policy_names", l.GetPolicyNames())
+	}
+
+	log.Printf("[WARN] Listener on port %d not found in Load Balancer %s, removing from state", port, lbName)
+	d.SetId("")
+
+	return nil
+}
+
+func resourceOutscaleOAPILoadBalancerListenerUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*OutscaleClient).OSCAPI
+
+	lbName := d.Get("load_balancer_name").(string)
+	port := int32(d.Get("load_balancer_port").(int))
+
+	if d.HasChange("server_certificate_id") {
+		req := oscgo.UpdateLoadBalancerRequest{
+			LoadBalancerName: lbName,
+		}
+		req.SetLoadBalancerPort(port)
+		req.SetServerCertificateId(d.Get("server_certificate_id").(string))
+		if err := updateOAPILoadBalancer(conn, req); err != nil {
+			return fmt.Errorf("Failure updating server_certificate_id of Load Balancer listener %s: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("policy_names") {
+		req := oscgo.UpdateLoadBalancerRequest{
+			LoadBalancerName: lbName,
+			PolicyNames:      expandSetStringList(d.Get("policy_names").(*schema.Set)),
+		}
+		req.SetLoadBalancerPort(port)
+		if err := updateOAPILoadBalancer(conn, req); err != nil {
+			return fmt.Errorf("Failure updating policy_names of Load Balancer listener %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceOutscaleOAPILoadBalancerListenerRead(d, meta)
+}
+
+func resourceOutscaleOAPILoadBalancerListenerDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*OutscaleClient).OSCAPI
+
+	req := oscgo.DeleteLoadBalancerListenersRequest{
+		LoadBalancerName:  d.Get("load_balancer_name").(string),
+		LoadBalancerPorts: []int32{int32(d.Get("load_balancer_port").(int))},
+	}
+
+	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		_, _, err := conn.ListenerApi.DeleteLoadBalancerListeners(
+			context.Background()).DeleteLoadBalancerListenersRequest(req).Execute()
+		if err != nil {
+			if strings.Contains(err.Error(), "Throttling:") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Failure deleting Load Balancer listener %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceOutscaleOAPILoadBalancerListenerImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	lbName, port, err := parseOAPILoadBalancerListenerID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("load_balancer_name", lbName); err != nil {
+		return nil, err
+	}
+	if err := d.Set("load_balancer_port", port); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// parseOAPILoadBalancerListenerID splits an ID of the form
+// {load_balancer_name}:{load_balancer_port}.
+func parseOAPILoadBalancerListenerID(id string) (string, int, error) {
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 || parts[0] == "" {
+		return "", 0, errors.New("import format error: to import a Load Balancer Listener, use the format {load_balancer_name}:{load_balancer_port}")
+	}
+
+	port, err := strconv.Atoi(parts[1])
+	if err != nil || port < 1 || port > 65535 {
+		return "", 0, fmt.Errorf("import format error: invalid load_balancer_port %q", parts[1])
+	}
+
+	return parts[0], port, nil
+}
+
+func updateOAPILoadBalancer(conn *oscgo.APIClient, req oscgo.UpdateLoadBalancerRequest) error {
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		_, _, err := conn.LoadBalancerApi.UpdateLoadBalancer(
+			context.Background()).UpdateLoadBalancerRequest(req).Execute()
+		if err != nil {
+			if strings.Contains(err.Error(), "Throttling:") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+}
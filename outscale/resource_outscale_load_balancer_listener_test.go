@@ -0,0 +1,140 @@
+package outscale
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	oscgo "github.com/outscale/osc-sdk-go/v2"
+)
+
+func TestAccOutscaleOAPILoadBalancerListener_basic(t *testing.T) {
+	var conf oscgo.LoadBalancer
+	region := os.Getenv("OUTSCALE_REGION")
+	lbName := fmt.Sprintf("tf-test-lb-lis-%s", acctest.RandString(5))
+	resourceName := "outscale_load_balancer_listener.bar"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOutscaleOAPILBUDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOutscaleOAPILoadBalancerListenerConfig(region, lbName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOutscaleOAPILBUExists("outscale_load_balancer.bar", &conf),
+					resource.TestCheckResourceAttr(resourceName, "load_balancer_port", "8080"),
+					resource.TestCheckResourceAttr(resourceName, "backend_protocol", "HTTP"),
+					resource.TestCheckResourceAttr(resourceName, "policy_names.#", "0"),
+					resource.TestCheckResourceAttr("outscale_load_balancer.bar", "listeners.#", "1"),
+				),
+			},
+			{
+				Config: testAccOutscaleOAPILoadBalancerListenerConfig(region, lbName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOutscaleOAPILBUExists("outscale_load_balancer.bar", &conf),
+					resource.TestCheckResourceAttr(resourceName, "policy_names.#", "1"),
+					resource.TestCheckResourceAttr("outscale_load_balancer.bar", "listeners.#", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("%s:8080", lbName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"request_id"},
+			},
+		},
+	})
+}
+
+func TestParseOAPILoadBalancerListenerID(t *testing.T) {
+	lbName, port, err := parseOAPILoadBalancerListenerID("my-lb:443")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if lbName != "my-lb" || port != 443 {
+		t.Fatalf("unexpected result: %q, %d", lbName, port)
+	}
+
+	for _, id := range []string{"my-lb", "my-lb:", ":443", "my-lb:https", "my-lb:0", "my-lb:65536", ""} {
+		if _, _, err := parseOAPILoadBalancerListenerID(id); err == nil {
+			t.Fatalf("expected an error for ID %q", id)
+		}
+	}
+}
+
+func TestCustomizeDiffOAPILoadBalancerListener(t *testing.T) {
+	cases := []struct {
+		certificate string
+		forceNew    bool
+	}{
+		{"", true},
+		{"orn:ows:idauth::012345678910:server-certificate/Other", false},
+	}
+
+	r := resourceOutscaleOAPILoadBalancerListener()
+	for _, c := range cases {
+		attrs := map[string]interface{}{
+			"load_balancer_name":     "lb",
+			"load_balancer_port":     443,
+			"load_balancer_protocol": "HTTPS",
+			"backend_port":           8000,
+			"backend_protocol":       "HTTP",
+		}
+		old := schema.TestResourceDataRaw(t, r.Schema, attrs)
+		old.Set("server_certificate_id", "orn:ows:idauth::012345678910:server-certificate/Cert")
+		old.SetId("lb:443")
+
+		if c.certificate != "" {
+			attrs["server_certificate_id"] = c.certificate
+		}
+		diff, err := r.Diff(old.State(), terraform.NewResourceConfigRaw(attrs), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff.RequiresNew() != c.forceNew {
+			t.Errorf("server_certificate_id %q: expected RequiresNew %t", c.certificate, c.forceNew)
+		}
+	}
+}
+
+func testAccOutscaleOAPILoadBalancerListenerConfig(region, lbName string, withPolicy bool) string {
+	policyNames := "[]"
+	if withPolicy {
+		policyNames = "[outscale_load_balancer_policy.bar.policy_name]"
+	}
+
+	return fmt.Sprintf(`
+resource "outscale_load_balancer" "bar" {
+  load_balancer_name = "%s"
+  subregion_names    = ["%sa"]
+  listeners {
+    backend_port           = 8000
+    backend_protocol       = "HTTP"
+    load_balancer_port     = 80
+    load_balancer_protocol = "HTTP"
+  }
+}
+
+resource "outscale_load_balancer_policy" "bar" {
+  load_balancer_name = outscale_load_balancer.bar.load_balancer_name
+  policy_name        = "tf-test-lb-listener-policy"
+  policy_type        = "load_balancer"
+}
+
+resource "outscale_load_balancer_listener" "bar" {
+  load_balancer_name     = outscale_load_balancer.bar.load_balancer_name
+  load_balancer_port     = 8080
+  load_balancer_protocol = "HTTP"
+  backend_port           = 8080
+  backend_protocol       = "HTTP"
+  policy_names           = %s
+}
+`, lbName, region, policyNames)
+}
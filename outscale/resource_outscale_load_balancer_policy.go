@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 )
 
 func resourceOutscaleAppCookieStickinessPolicy() *schema.Resource {
@@ -119,6 +120,10 @@ func resourceOutscaleAppCookieStickinessPolicy() *schema.Resource {
 				ForceNew: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"cookie_expiration_period": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
 						"policy_name": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -215,6 +220,12 @@ func resourceOutscaleAppCookieStickinessPolicy() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"cookie_expiration_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 			"request_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -244,6 +255,9 @@ func resourceOutscaleAppCookieStickinessPolicyCreate(d *schema.ResourceData, met
 	if cnok {
 		req.CookieName = &vs
 	}
+	if v, ok := d.GetOk("cookie_expiration_period"); ok {
+		req.SetCookieExpirationPeriod(int32(v.(int)))
+	}
 
 	var err error
 	var resp oscgo.CreateLoadBalancerPolicyResponse
@@ -310,6 +324,7 @@ func resourceOutscaleAppCookieStickinessPolicyCreate(d *schema.ResourceData, met
 				len(*lb.LoadBalancerStickyCookiePolicies))
 			for k, v := range *lb.LoadBalancerStickyCookiePolicies {
 				a := make(map[string]interface{})
+				a["cookie_expiration_period"] = v.GetCookieExpirationPeriod()
 				a["policy_name"] = v.PolicyName
 				lbc[k] = a
 			}
@@ -42,6 +42,31 @@ func TestAccOutscaleAppCookieStickinessPolicy_basic(t *testing.T) {
 	})
 }
 
+func TestAccOutscaleLBCookieStickinessPolicy_cookieExpirationPeriod(t *testing.T) {
+	lbName := fmt.Sprintf("tf-test-lb-%s", acctest.RandString(5))
+	region := os.Getenv("OUTSCALE_REGION")
+	zone := fmt.Sprintf("%sa", region)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAppCookieStickinessPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBCookieStickinessPolicyConfig(lbName, zone),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppCookieStickinessPolicy(
+						"outscale_load_balancer.lb",
+						"outscale_load_balancer_policy.foo",
+					),
+					resource.TestCheckResourceAttr("outscale_load_balancer_policy.foo", "cookie_expiration_period", "600"),
+					resource.TestCheckResourceAttr("data.outscale_load_balancer.lb", "load_balancer_sticky_cookie_policies.0.cookie_expiration_period", "600"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAppCookieStickinessPolicyDestroy(s *terraform.State) error {
 
 	for _, rs := range s.RootModule().Resources {
@@ -113,3 +138,28 @@ resource "outscale_load_balancer_policy" "foo" {
 	cookie_name = "MyOtherAppCookie"
 }`, rName, zone)
 }
+
+func testAccLBCookieStickinessPolicyConfig(rName string, zone string) string {
+	return fmt.Sprintf(`
+resource "outscale_load_balancer" "lb" {
+	load_balancer_name = "%s"
+	subregion_names = ["%s"]
+  listeners {
+    backend_port = 8000
+    backend_protocol = "HTTP"
+    load_balancer_port = 80
+    load_balancer_protocol = "HTTP"
+  }
+}
+
+resource "outscale_load_balancer_policy" "foo" {
+	policy_type = "load_balancer"
+	policy_name = "foo-policy"
+	load_balancer_name = outscale_load_balancer.lb.id
+	cookie_expiration_period = 600
+}
+
+data "outscale_load_balancer" "lb" {
+	load_balancer_name = outscale_load_balancer_policy.foo.load_balancer_name
+}`, rName, zone)
+}
@@ -46,6 +46,56 @@ func TestAccOutscaleOAPILBUBasic(t *testing.T) {
 	})
 }
 
+func TestAccOutscaleOAPILBUPublicIP(t *testing.T) {
+	var conf oscgo.LoadBalancer
+
+	r := acctest.RandIntRange(0, 50)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: "outscale_load_balancer.bar",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckOutscaleOAPILBUDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOutscaleOAPILBUPublicIPConfig(r, "ip1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOutscaleOAPILBUExists("outscale_load_balancer.bar", &conf),
+					resource.TestCheckResourceAttrPair(
+						"outscale_load_balancer.bar", "public_ip",
+						"outscale_public_ip.ip1", "public_ip"),
+				),
+			},
+			{
+				Config: testAccOutscaleOAPILBUPublicIPConfig(r, "ip2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOutscaleOAPILBUExists("outscale_load_balancer.bar", &conf),
+					resource.TestCheckResourceAttrPair(
+						"outscale_load_balancer.bar", "public_ip",
+						"outscale_public_ip.ip2", "public_ip"),
+				),
+			},
+		},
+	})
+}
+
+func TestFilterOAPIListenersByPort(t *testing.T) {
+	listeners := []map[string]interface{}{
+		{"load_balancer_port": 80, "load_balancer_protocol": "HTTP"},
+		{"load_balancer_port": 8080, "load_balancer_protocol": "HTTP"},
+	}
+	known := []interface{}{
+		map[string]interface{}{"load_balancer_port": 80, "load_balancer_protocol": "HTTP"},
+	}
+
+	result := filterOAPIListenersByPort(listeners, known)
+	if len(result) != 1 || result[0]["load_balancer_port"].(int) != 80 {
+		t.Fatalf("unexpected listeners: %v", result)
+	}
+}
+
 func testAccCheckOutscaleOAPILBUDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*OutscaleClient).OSCAPI
 
@@ -175,3 +225,23 @@ resource "outscale_load_balancer" "bar" {
 }
 `, os.Getenv("OUTSCALE_REGION"), r)
 }
+
+func testAccOutscaleOAPILBUPublicIPConfig(r int, ip string) string {
+	return fmt.Sprintf(`
+resource "outscale_public_ip" "ip1" {}
+
+resource "outscale_public_ip" "ip2" {}
+
+resource "outscale_load_balancer" "bar" {
+  subregion_names    = ["%sa"]
+  load_balancer_name = "foobar-terraform-elb-%d"
+  public_ip          = outscale_public_ip.%s.public_ip
+  listeners {
+    backend_port = 8000
+    backend_protocol = "HTTP"
+    load_balancer_port = 80
+    load_balancer_protocol = "HTTP"
+  }
+}
+`, os.Getenv("OUTSCALE_REGION"), r, ip)
+}
@@ -0,0 +1,139 @@
+package outscale
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceOutscaleOAPILoadBalancerVM() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOutscaleOAPILoadBalancerVMCreate,
+		Read:   resourceOutscaleOAPILoadBalancerVMRead,
+		Update: resourceOutscaleOAPILoadBalancerVMRead,
+		Delete: resourceOutscaleOAPILoadBalancerVMDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceOutscaleOAPILoadBalancerVMImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"load_balancer_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"backend_vm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"wait_for_healthy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"request_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceOutscaleOAPILoadBalancerVMCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*OutscaleClient).OSCAPI
+
+	lbName := d.Get("load_balancer_name").(string)
+	vmID := d.Get("backend_vm_id").(string)
+
+	if err := registerOAPILBUVms(conn, lbName, []string{vmID}); err != nil {
+		return fmt.Errorf("Failure registering VM %s with LBU %s: %s", vmID, lbName, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s_%s", lbName, vmID))
+
+	if d.Get("wait_for_healthy").(bool) {
+		if err := waitForOAPILBUVmsHealthy(conn, lbName, []string{vmID}, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceOutscaleOAPILoadBalancerVMRead(d, meta)
+}
+
+func resourceOutscaleOAPILoadBalancerVMRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*OutscaleClient).OSCAPI
+
+	lbName := d.Get("load_balancer_name").(string)
+	vmID := d.Get("backend_vm_id").(string)
+
+	lb, _, err := readResourceLb(conn, lbName)
+	if err != nil {
+		if isOAPILBUNotFound(err) {
+			log.Printf("[WARN] Load Balancer %s not found, removing from state", lbName)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	for _, id := range lb.GetBackendVmIds() {
+		if id == vmID {
+			return nil
+		}
+	}
+
+	log.Printf("[WARN] VM %s not registered with Load Balancer %s, removing from state", vmID, lbName)
+	d.SetId("")
+
+	return nil
+}
+
+func resourceOutscaleOAPILoadBalancerVMDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*OutscaleClient).OSCAPI
+
+	lbName := d.Get("load_balancer_name").(string)
+	vmID := d.Get("backend_vm_id").(string)
+
+	if err := deregisterOAPILBUVms(conn, lbName, []string{vmID}); err != nil {
+		return fmt.Errorf("Failure deregistering VM %s from LBU %s: %s", vmID, lbName, err)
+	}
+
+	return nil
+}
+
+func resourceOutscaleOAPILoadBalancerVMImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	lbName, vmID, err := parseOAPILoadBalancerVMID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("load_balancer_name", lbName); err != nil {
+		return nil, err
+	}
+	if err := d.Set("backend_vm_id", vmID); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// parseOAPILoadBalancerVMID splits an ID of the form
+// {load_balancer_name}_{backend_vm_id}. Load balancer names cannot contain
+// underscores, so the first one separates both parts.
+func parseOAPILoadBalancerVMID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "_", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", errors.New("import format error: to import a Load Balancer VM, use the format {load_balancer_name}_{backend_vm_id}")
+	}
+
+	return parts[0], parts[1], nil
+}
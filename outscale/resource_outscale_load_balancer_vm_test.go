@@ -0,0 +1,99 @@
+package outscale
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	oscgo "github.com/outscale/osc-sdk-go/v2"
+)
+
+func TestAccOutscaleOAPILoadBalancerVM_basic(t *testing.T) {
+	var conf oscgo.LoadBalancer
+	omi := os.Getenv("OUTSCALE_IMAGEID")
+	region := os.Getenv("OUTSCALE_REGION")
+	lbName := fmt.Sprintf("tf-test-lb-vm-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOutscaleOAPILBUDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOutscaleOAPILoadBalancerVMConfig(omi, region, lbName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOutscaleOAPILBUExists("outscale_load_balancer.bar", &conf),
+					func(*terraform.State) error {
+						if len(conf.GetBackendVmIds()) != 2 {
+							return fmt.Errorf("expected 2 backend VMs, got %d", len(conf.GetBackendVmIds()))
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttr("outscale_load_balancer_vms.foo", "backend_vm_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("outscale_load_balancer_vm.bar", "backend_vm_id", "outscale_vm.bar", "vm_id"),
+				),
+			},
+			{
+				ResourceName:            "outscale_load_balancer_vm.bar",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"request_id", "wait_for_healthy"},
+			},
+		},
+	})
+}
+
+func TestParseOAPILoadBalancerVMID(t *testing.T) {
+	lbName, vmID, err := parseOAPILoadBalancerVMID("my-lb_i-12345678")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if lbName != "my-lb" || vmID != "i-12345678" {
+		t.Fatalf("unexpected result: %q, %q", lbName, vmID)
+	}
+
+	for _, id := range []string{"my-lb", "my-lb_", "_i-12345678", ""} {
+		if _, _, err := parseOAPILoadBalancerVMID(id); err == nil {
+			t.Fatalf("expected an error for ID %q", id)
+		}
+	}
+}
+
+func testAccOutscaleOAPILoadBalancerVMConfig(omi, region, lbName string) string {
+	return fmt.Sprintf(`
+resource "outscale_load_balancer" "bar" {
+  load_balancer_name = "%s"
+  subregion_names    = ["%sa"]
+  listeners {
+    backend_port           = 8000
+    backend_protocol       = "HTTP"
+    load_balancer_port     = 80
+    load_balancer_protocol = "HTTP"
+  }
+}
+
+resource "outscale_vm" "foo" {
+  image_id = "%[3]s"
+  vm_type  = "tinav4.c1r1p1"
+}
+
+resource "outscale_vm" "bar" {
+  image_id = "%[3]s"
+  vm_type  = "tinav4.c1r1p1"
+}
+
+resource "outscale_load_balancer_vms" "foo" {
+  load_balancer_name = outscale_load_balancer.bar.id
+  backend_vm_ids     = [outscale_vm.foo.vm_id]
+}
+
+resource "outscale_load_balancer_vm" "bar" {
+  load_balancer_name = outscale_load_balancer.bar.id
+  backend_vm_id      = outscale_vm.bar.vm_id
+}
+`, lbName, region, omi)
+}
@@ -17,7 +17,16 @@ func resourceOutscaleOAPILBUAttachment() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceOutscaleOAPILBUAttachmentCreate,
 		Read:   resourceOutscaleOAPILBUAttachmentRead,
+		Update: resourceOutscaleOAPILBUAttachmentUpdate,
 		Delete: resourceOutscaleOAPILBUAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceOutscaleOAPILBUAttachmentImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"load_balancer_name": {
@@ -27,11 +36,15 @@ func resourceOutscaleOAPILBUAttachment() *schema.Resource {
 			},
 
 			"backend_vm_ids": {
-				Type:     schema.TypeList,
-				ForceNew: true,
+				Type:     schema.TypeSet,
 				Required: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"wait_for_healthy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"request_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -43,96 +56,159 @@ func resourceOutscaleOAPILBUAttachment() *schema.Resource {
 func resourceOutscaleOAPILBUAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*OutscaleClient).OSCAPI
 
-	e, eok := d.GetOk("load_balancer_name")
-	i, iok := d.GetOk("backend_vm_ids")
-
-	if !eok && !iok {
-		return fmt.Errorf("please provide the required attributes load_balancer_name and backend_vm_id")
-	}
-
-	m := i.([]interface{})
-	a := make([]string, len(m))
-	for k, v := range m {
-		a[k] = v.(string)
-	}
+	lbName := d.Get("load_balancer_name").(string)
+	vmIDs := expandInstanceString(d.Get("backend_vm_ids").(*schema.Set).List())
 
-	req := oscgo.RegisterVmsInLoadBalancerRequest{
-		LoadBalancerName: e.(string),
-		BackendVmIds:     a,
+	if err := registerOAPILBUVms(conn, lbName, vmIDs); err != nil {
+		return fmt.Errorf("Failure registering backend_vm_ids with LBU: %s", err)
 	}
 
-	var err error
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
-		_, _, err = conn.LoadBalancerApi.
-			RegisterVmsInLoadBalancer(context.Background()).
-			RegisterVmsInLoadBalancerRequest(req).
-			Execute()
+	d.SetId(lbName)
 
-		if err != nil {
-			if strings.Contains(fmt.Sprint(err), "Throttling") {
-				return resource.RetryableError(
-					fmt.Errorf("[WARN] Error, retrying: %s", err))
-			}
-			return resource.NonRetryableError(err)
+	// The VMs are registered, so they are saved in the state even if the wait
+	// fails, and the resource is tainted.
+	if d.Get("wait_for_healthy").(bool) {
+		if err := waitForOAPILBUVmsHealthy(conn, lbName, vmIDs, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
 		}
-		return nil
-	})
-
-	if err != nil {
-		return fmt.Errorf("Failure registering backend_vm_ids with LBU: %s", err)
 	}
 
-	d.SetId(resource.PrefixedUniqueId(fmt.Sprintf("%s-", e)))
-
 	return resourceOutscaleOAPILBUAttachmentRead(d, meta)
 }
 
 func resourceOutscaleOAPILBUAttachmentRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*OutscaleClient).OSCAPI
-	found := false
-	e := d.Get("load_balancer_name").(string)
-	lb, _, err := readResourceLb(conn, e)
-	expected := d.Get("backend_vm_ids").([]interface{})
+	lbName := d.Get("load_balancer_name").(string)
 
+	lb, _, err := readResourceLb(conn, lbName)
 	if err != nil {
+		if isOAPILBUNotFound(err) {
+			log.Printf("[WARN] Load Balancer %s not found, removing from state", lbName)
+			d.SetId("")
+			return nil
+		}
 		return err
 	}
-	for _, v := range *lb.BackendVmIds {
-		for k1 := range expected {
-			sid := expected[k1].(string)
-			if sid == v {
-				d.Set("backend_vm_ids", expected)
-				found = true
-			}
-		}
+
+	// Only the VMs managed by this resource are kept, so that VMs registered
+	// by other means (e.g. outscale_load_balancer_vm) do not show up as drift.
+	// On import, every registered VM is managed by this resource.
+	vmIDs := schema.NewSet(schema.HashString, flattenStringList(lb.BackendVmIds))
+	if expected, ok := d.GetOk("backend_vm_ids"); ok {
+		vmIDs = expected.(*schema.Set).Intersection(vmIDs)
 	}
 
-	if !found {
-		log.Printf("[WARN] i %s not found in lbu attachments", expected)
+	if vmIDs.Len() == 0 {
+		log.Printf("[WARN] No backend_vm_ids found in Load Balancer %s, removing from state", lbName)
 		d.SetId("")
+		return nil
 	}
 
-	return nil
+	return d.Set("backend_vm_ids", vmIDs)
+}
+
+func resourceOutscaleOAPILBUAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*OutscaleClient).OSCAPI
+	lbName := d.Get("load_balancer_name").(string)
+
+	if d.HasChange("backend_vm_ids") {
+		o, n := d.GetChange("backend_vm_ids")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		// If the update fails, only the VMs which are registered are saved in
+		// the state, so that the VMs to remove are not forgotten.
+		d.Partial(true)
+
+		// New VMs are registered first so the load balancer never runs
+		// without backends while the membership is being changed.
+		if add := ns.Difference(os); add.Len() > 0 {
+			vmIDs := expandInstanceString(add.List())
+			if err := registerOAPILBUVms(conn, lbName, vmIDs); err != nil {
+				return fmt.Errorf("Failure registering backend_vm_ids with LBU: %s", err)
+			}
+			if err := d.Set("backend_vm_ids", os.Union(add)); err != nil {
+				return err
+			}
+			d.SetPartial("backend_vm_ids")
+		}
+
+		// Every VM to keep is waited for, and not only the new ones: after a
+		// failed wait, the new VMs are already in the state, and the VMs to
+		// remove must not be deregistered before the new ones are healthy.
+		if d.Get("wait_for_healthy").(bool) {
+			vmIDs := expandInstanceString(ns.List())
+			if err := waitForOAPILBUVmsHealthy(conn, lbName, vmIDs, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		}
+
+		if remove := expandInstanceString(os.Difference(ns).List()); len(remove) > 0 {
+			if err := deregisterOAPILBUVms(conn, lbName, remove); err != nil {
+				return fmt.Errorf("Failure deregistering backend_vm_ids from LBU: %s", err)
+			}
+		}
+
+		d.Partial(false)
+	}
+
+	return resourceOutscaleOAPILBUAttachmentRead(d, meta)
 }
 
 func resourceOutscaleOAPILBUAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*OutscaleClient).OSCAPI
-	e := d.Get("load_balancer_name").(string)
-	i := d.Get("backend_vm_ids").([]interface{})
+	lbName := d.Get("load_balancer_name").(string)
+	vmIDs := expandInstanceString(d.Get("backend_vm_ids").(*schema.Set).List())
+
+	if err := deregisterOAPILBUVms(conn, lbName, vmIDs); err != nil {
+		return fmt.Errorf("Failure deregistering backend_vm_ids from LBU: %s", err)
+	}
+
+	return nil
+}
+
+func resourceOutscaleOAPILBUAttachmentImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if err := d.Set("load_balancer_name", d.Id()); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
 
-	lb := make([]string, len(i))
+func isOAPILBUNotFound(err error) bool {
+	return strings.Contains(fmt.Sprint(err), "Unable to find LoadBalancer")
+}
 
-	for k, v := range i {
-		lb[k] = v.(string)
+func registerOAPILBUVms(conn *oscgo.APIClient, lbName string, vmIDs []string) error {
+	req := oscgo.RegisterVmsInLoadBalancerRequest{
+		LoadBalancerName: lbName,
+		BackendVmIds:     vmIDs,
 	}
 
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		_, _, err := conn.LoadBalancerApi.
+			RegisterVmsInLoadBalancer(context.Background()).
+			RegisterVmsInLoadBalancerRequest(req).
+			Execute()
+
+		if err != nil {
+			if strings.Contains(fmt.Sprint(err), "Throttling") {
+				return resource.RetryableError(
+					fmt.Errorf("[WARN] Error, retrying: %s", err))
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+}
+
+func deregisterOAPILBUVms(conn *oscgo.APIClient, lbName string, vmIDs []string) error {
 	req := oscgo.DeregisterVmsInLoadBalancerRequest{
-		LoadBalancerName: e,
-		BackendVmIds:     lb,
+		LoadBalancerName: lbName,
+		BackendVmIds:     vmIDs,
 	}
 
-	var err error
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
 		_, _, err := conn.LoadBalancerApi.
 			DeregisterVmsInLoadBalancer(context.Background()).
 			DeregisterVmsInLoadBalancerRequest(req).
@@ -147,10 +223,66 @@ func resourceOutscaleOAPILBUAttachmentDelete(d *schema.ResourceData, meta interf
 		}
 		return nil
 	})
+}
 
-	if err != nil {
-		return fmt.Errorf("Failure deregistering backend_vm_ids from LBU: %s", err)
+// waitForOAPILBUVmsHealthy waits for all the given back-end VMs to be UP in
+// the load balancer. On failure, the error reports the state and state reason
+// of each VM which is not UP.
+func waitForOAPILBUVmsHealthy(conn *oscgo.APIClient, lbName string, vmIDs []string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"unhealthy"},
+		Target:  []string{"healthy"},
+		Refresh: func() (interface{}, string, error) {
+			health, err := readOAPILBUVmsHealth(conn, lbName, vmIDs)
+			if err != nil {
+				return nil, "", err
+			}
+			if len(unhealthyOAPILBUVms(health, vmIDs)) > 0 {
+				return health, "unhealthy", nil
+			}
+			return health, "healthy", nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		health, herr := readOAPILBUVmsHealth(conn, lbName, vmIDs)
+		if herr != nil {
+			return fmt.Errorf("Error waiting for backend VMs of Load Balancer %s to be healthy: %s", lbName, err)
+		}
+		reasons := make([]string, 0, len(vmIDs))
+		for _, h := range unhealthyOAPILBUVms(health, vmIDs) {
+			reasons = append(reasons, fmt.Sprintf("%s: %s (%s)", h.GetVmId(), h.GetState(), h.GetStateReason()))
+		}
+		return fmt.Errorf("Error waiting for backend VMs of Load Balancer %s to be healthy: %s\n%s",
+			lbName, err, strings.Join(reasons, "\n"))
 	}
 
 	return nil
 }
+
+// unhealthyOAPILBUVms returns the health of the given VMs which are not UP.
+// A VM missing from the health report is considered unhealthy.
+func unhealthyOAPILBUVms(health []oscgo.BackendVmHealth, vmIDs []string) []oscgo.BackendVmHealth {
+	byID := make(map[string]oscgo.BackendVmHealth, len(health))
+	for _, h := range health {
+		byID[h.GetVmId()] = h
+	}
+
+	var unhealthy []oscgo.BackendVmHealth
+	for _, id := range vmIDs {
+		h, ok := byID[id]
+		if !ok {
+			h = oscgo.BackendVmHealth{}
+			h.SetVmId(id)
+			h.SetState("UNKNOWN")
+			h.SetStateReason("not reported by the load balancer")
+		}
+		if h.GetState() != "UP" {
+			unhealthy = append(unhealthy, h)
+		}
+	}
+	return unhealthy
+}
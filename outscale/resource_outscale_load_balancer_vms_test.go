@@ -2,10 +2,16 @@ package outscale
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"reflect"
+	"sort"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 
 	oscgo "github.com/outscale/osc-sdk-go/v2"
@@ -41,11 +47,118 @@ func TestAccOutscaleOAPILBUAttachment_basic(t *testing.T) {
 					testCheckInstanceAttached(1),
 				),
 			},
+			{
+				Config: testAccOutscaleOAPILBUAttachmentConfig2(omi, region),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOutscaleOAPILBUExists("outscale_load_balancer.bar", &conf),
+					testCheckInstanceAttached(2),
+					resource.TestCheckResourceAttr("outscale_load_balancer_vms.foo1", "backend_vm_ids.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "outscale_load_balancer_vms.foo1",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources["outscale_load_balancer_vms.foo1"].Primary.Attributes["load_balancer_name"], nil
+				},
+				ImportStateVerifyIgnore: []string{"request_id", "wait_for_healthy"},
+			},
 		},
 	})
 }
 
+func TestUnhealthyOAPILBUVms(t *testing.T) {
+	up := oscgo.BackendVmHealth{}
+	up.SetVmId("i-00000001")
+	up.SetState("UP")
+	down := oscgo.BackendVmHealth{}
+	down.SetVmId("i-00000002")
+	down.SetState("DOWN")
+	down.SetStateReason("ELB")
+
+	unhealthy := unhealthyOAPILBUVms([]oscgo.BackendVmHealth{up, down}, []string{"i-00000001", "i-00000002", "i-00000003"})
+	if len(unhealthy) != 2 {
+		t.Fatalf("expected 2 unhealthy VMs, got %d", len(unhealthy))
+	}
+	if unhealthy[0].GetVmId() != "i-00000002" || unhealthy[0].GetStateReason() != "ELB" {
+		t.Fatalf("unexpected unhealthy VM: %+v", unhealthy[0])
+	}
+	if unhealthy[1].GetVmId() != "i-00000003" || unhealthy[1].GetState() != "UNKNOWN" {
+		t.Fatalf("unexpected unhealthy VM: %+v", unhealthy[1])
+	}
+
+	if unhealthy := unhealthyOAPILBUVms([]oscgo.BackendVmHealth{up}, []string{"i-00000001"}); len(unhealthy) != 0 {
+		t.Fatalf("expected no unhealthy VMs, got %d", len(unhealthy))
+	}
+}
+
 // add one attachment
+func TestResourceOutscaleOAPILBUAttachmentUpdate_failure(t *testing.T) {
+	cases := []struct {
+		failing  string
+		expected []string
+	}{
+		// Nothing changed.
+		{"RegisterVmsInLoadBalancer", []string{"i-00000001", "i-00000002"}},
+		// i-00000002 is still registered, and i-00000003 is registered.
+		{"DeregisterVmsInLoadBalancer", []string{"i-00000001", "i-00000002", "i-00000003"}},
+	}
+
+	for _, c := range cases {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			if strings.HasSuffix(r.URL.Path, "/"+c.failing) {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`{"Errors":[{"Code":"InvalidParameterValue"}]}`))
+				return
+			}
+			w.Write([]byte(`{}`))
+		}))
+
+		config := oscgo.NewConfiguration()
+		config.Scheme = "http"
+		config.Host = strings.TrimPrefix(server.URL, "http://")
+		meta := &OutscaleClient{OSCAPI: oscgo.NewAPIClient(config)}
+
+		r := resourceOutscaleOAPILBUAttachment()
+		old := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+			"load_balancer_name": "lbu",
+			"backend_vm_ids":     []interface{}{"i-00000001", "i-00000002"},
+		})
+		old.SetId("lbu")
+		state := old.State()
+
+		diff, err := r.Diff(state, terraform.NewResourceConfigRaw(map[string]interface{}{
+			"load_balancer_name": "lbu",
+			"backend_vm_ids":     []interface{}{"i-00000001", "i-00000003"},
+		}), meta)
+		if err != nil {
+			t.Fatal(err)
+		}
+		d, err := schema.InternalMap(r.Schema).Data(state, diff)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = resourceOutscaleOAPILBUAttachmentUpdate(d, meta)
+		server.Close()
+		if err == nil {
+			t.Fatalf("%s: expected the update to fail", c.failing)
+		}
+
+		saved, err := schema.InternalMap(r.Schema).Data(d.State(), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		vmIDs := expandInstanceString(saved.Get("backend_vm_ids").(*schema.Set).List())
+		sort.Strings(vmIDs)
+		if !reflect.DeepEqual(vmIDs, c.expected) {
+			t.Errorf("%s: expected %v in state, got %v", c.failing, c.expected, vmIDs)
+		}
+	}
+}
+
 func testAccOutscaleOAPILBUAttachmentConfig1(omi, region string) string {
 	return fmt.Sprintf(`
 resource "outscale_load_balancer" "bar" {
@@ -70,3 +183,34 @@ resource "outscale_load_balancer_vms" "foo1" {
 }
 `, region, omi)
 }
+
+// add a second attachment without replacing the first one
+func testAccOutscaleOAPILBUAttachmentConfig2(omi, region string) string {
+	return fmt.Sprintf(`
+resource "outscale_load_balancer" "bar" {
+	load_balancer_name = "load-test12"
+	subregion_names = ["%sa"]
+    listeners {
+    backend_port = 8000
+    backend_protocol = "HTTP"
+    load_balancer_port = 80
+    load_balancer_protocol = "HTTP"
+  }
+}
+
+resource "outscale_vm" "foo1" {
+  image_id = "%[2]s"
+  vm_type = "tinav4.c1r1p1"
+}
+
+resource "outscale_vm" "foo2" {
+  image_id = "%[2]s"
+  vm_type = "tinav4.c1r1p1"
+}
+
+resource "outscale_load_balancer_vms" "foo1" {
+  load_balancer_name = outscale_load_balancer.bar.id
+  backend_vm_ids     = [outscale_vm.foo1.id, outscale_vm.foo2.id]
+}
+`, region, omi)
+}
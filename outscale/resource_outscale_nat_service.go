@@ -23,6 +23,7 @@ func resourceOutscaleOAPINatService() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffOAPITagsAll,
 		Schema: map[string]*schema.Schema{
 			"public_ip_id": {
 				Type:     schema.TypeString,
@@ -67,7 +68,8 @@ func resourceOutscaleOAPINatService() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsListOAPISchema(),
+			"tags":     tagsListOAPISchema(),
+			"tags_all": tagsOAPIListSchemaComputed(),
 		},
 	}
 }
@@ -128,11 +130,8 @@ func resourceOAPINatServiceCreate(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("error waiting for NAT Service (%s) to become available: %s", natService.GetNatServiceId(), err)
 	}
 	//SetTags
-	if tags, ok := d.GetOk("tags"); ok {
-		err := assignTags(tags.(*schema.Set), natService.GetNatServiceId(), conn)
-		if err != nil {
-			return err
-		}
+	if err := assignTags(d.Get("tags").(*schema.Set), natService.GetNatServiceId(), meta.(*OutscaleClient)); err != nil {
+		return err
 	}
 
 	d.SetId(natService.GetNatServiceId())
@@ -188,7 +187,7 @@ func resourceOAPINatServiceRead(d *schema.ResourceData, meta interface{}) error
 			return err
 		}
 
-		if err := d.Set("tags", tagsOSCAPIToMap(natService.GetTags())); err != nil {
+		if err := setOSCAPITagsAttributes(d, meta.(*OutscaleClient), natService.GetTags()); err != nil {
 			fmt.Printf("[WARN] ERROR TAGS PROBLEME (%s)", err)
 		}
 
@@ -197,11 +196,11 @@ func resourceOAPINatServiceRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceOutscaleOAPINatServiceUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*OutscaleClient).OSCAPI
+	client := meta.(*OutscaleClient)
 
 	d.Partial(true)
 
-	if err := setOSCAPITags(conn, d); err != nil {
+	if err := setOSCAPITags(client, d); err != nil {
 		return err
 	}
 
@@ -22,6 +22,7 @@ func resourceOutscaleOAPINet() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffOAPITagsAll,
 
 		Schema: getOAPINetSchema(),
 	}
@@ -62,11 +63,8 @@ func resourceOutscaleOAPINetCreate(d *schema.ResourceData, meta interface{}) err
 	}
 
 	//SetTags
-	if tags, ok := d.GetOk("tags"); ok {
-		err := assignTags(tags.(*schema.Set), resp.Net.GetNetId(), conn)
-		if err != nil {
-			return err
-		}
+	if err := assignTags(d.Get("tags").(*schema.Set), resp.Net.GetNetId(), meta.(*OutscaleClient)); err != nil {
+		return err
 	}
 
 	d.SetId(resp.Net.GetNetId())
@@ -137,15 +135,15 @@ func resourceOutscaleOAPINetRead(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	return d.Set("tags", tagsOSCAPIToMap(resp.GetNets()[0].GetTags()))
+	return setOSCAPITagsAttributes(d, meta.(*OutscaleClient), resp.GetNets()[0].GetTags())
 }
 
 func resourceOutscaleOAPINetUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*OutscaleClient).OSCAPI
+	client := meta.(*OutscaleClient)
 
 	d.Partial(true)
 
-	if err := setOSCAPITags(conn, d); err != nil {
+	if err := setOSCAPITags(client, d); err != nil {
 		return err
 	}
 
@@ -215,7 +213,8 @@ func getOAPINetSchema() map[string]*schema.Schema {
 			Type:     schema.TypeString,
 			Computed: true,
 		},
-		"tags": tagsListOAPISchema(),
+		"tags":     tagsListOAPISchema(),
+		"tags_all": tagsOAPIListSchemaComputed(),
 		"net_id": {
 			Type:     schema.TypeString,
 			Computed: true,
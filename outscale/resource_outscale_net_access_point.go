@@ -23,6 +23,7 @@ func resourceOutscaleNetAccessPoint() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffOAPITagsAll,
 
 		Schema: map[string]*schema.Schema{
 			"net_access_point_id": {
@@ -48,7 +49,8 @@ func resourceOutscaleNetAccessPoint() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsListOAPISchema(),
+			"tags":     tagsListOAPISchema(),
+			"tags_all": tagsOAPIListSchemaComputed(),
 			"request_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -115,11 +117,11 @@ func resourceOutscaleNetAccessPointUpdate(d *schema.ResourceData, meta interface
 		})
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags") || d.HasChange("tags_all") {
 
 		d.Partial(true)
 
-		if err := setOSCAPITags(conn, d); err != nil {
+		if err := setOSCAPITags(meta.(*OutscaleClient), d); err != nil {
 			return err
 		}
 
@@ -189,11 +191,8 @@ func resourceOutscaleNetAccessPointCreate(d *schema.ResourceData, meta interface
 	}
 
 	//SetTags
-	if tags, ok := d.GetOk("tags"); ok {
-		err := assignTags(tags.(*schema.Set), resp.NetAccessPoint.GetNetAccessPointId(), conn)
-		if err != nil {
-			return err
-		}
+	if err := assignTags(d.Get("tags").(*schema.Set), resp.NetAccessPoint.GetNetAccessPointId(), meta.(*OutscaleClient)); err != nil {
+		return err
 	}
 
 	id := *resp.NetAccessPoint.NetAccessPointId
@@ -256,7 +255,7 @@ func resourceOutscaleNetAccessPointRead(d *schema.ResourceData, meta interface{}
 	d.Set("net_id", nap.NetId)
 	d.Set("service_name", nap.ServiceName)
 	d.Set("state", nap.State)
-	d.Set("tags", tagsOSCAPIToMap(nap.GetTags()))
+	setOSCAPITagsAttributes(d, meta.(*OutscaleClient), nap.GetTags())
 	d.Set("net_access_point_id", nap.GetNetAccessPointId())
 
 	return nil
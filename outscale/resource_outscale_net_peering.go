@@ -25,6 +25,7 @@ func resourceOutscaleOAPILinPeeringConnection() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffOAPITagsAll,
 
 		Schema: map[string]*schema.Schema{
 			"source_net_account_id": {
@@ -68,6 +69,7 @@ func resourceOutscaleOAPILinPeeringConnection() *schema.Resource {
 			"accepter_net": vpcOAPIPeeringConnectionOptionsSchema(),
 			"source_net":   vpcOAPIPeeringConnectionOptionsSchema(),
 			"tags":         tagsListOAPISchema(),
+			"tags_all":     tagsOAPIListSchemaComputed(),
 			"request_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -112,11 +114,8 @@ func resourceOutscaleOAPILinPeeringCreate(d *schema.ResourceData, meta interface
 	d.SetId(resp.NetPeering.GetNetPeeringId())
 
 	//SetTags
-	if tags, ok := d.GetOk("tags"); ok {
-		err := assignTags(tags.(*schema.Set), d.Id(), conn)
-		if err != nil {
-			return err
-		}
+	if err := assignTags(d.Get("tags").(*schema.Set), d.Id(), meta.(*OutscaleClient)); err != nil {
+		return err
 	}
 
 	log.Printf("[INFO] Net Peering ID: %s", d.Id())
@@ -232,7 +231,7 @@ func resourceOutscaleOAPILinPeeringRead(d *schema.ResourceData, meta interface{}
 	if err := d.Set("net_peering_id", pc.GetNetPeeringId()); err != nil {
 		return err
 	}
-	if err := d.Set("tags", tagsOSCAPIToMap(pc.GetTags())); err != nil {
+	if err := setOSCAPITagsAttributes(d, meta.(*OutscaleClient), pc.GetTags()); err != nil {
 		return errwrap.Wrapf("Error setting Net Peering tags: {{err}}", err)
 	}
 
@@ -240,11 +239,11 @@ func resourceOutscaleOAPILinPeeringRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceOutscaleOAPINetPeeringUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*OutscaleClient).OSCAPI
+	client := meta.(*OutscaleClient)
 
 	d.Partial(true)
 
-	if err := setOSCAPITags(conn, d); err != nil {
+	if err := setOSCAPITags(client, d); err != nil {
 		return err
 	}
 
@@ -55,6 +55,29 @@ func TestAccOutscaleOAPILin_UpdateTags(t *testing.T) {
 	})
 }
 
+func TestAccOutscaleOAPILin_DefaultTags(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOutscaleOAPILinConfigDefaultTags("Terraform_net"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("outscale_net.outscale_net", "tags.#", "1"),
+					resource.TestCheckResourceAttr("outscale_net.outscale_net", "tags_all.#", "2"),
+				),
+			},
+			{
+				Config: testAccOutscaleOAPILinConfigDefaultTags("Terraform_net2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("outscale_net.outscale_net", "tags.#", "1"),
+					resource.TestCheckResourceAttr("outscale_net.outscale_net", "tags_all.#", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckOutscaleOAPILinExists(n string, res *oscgo.Net) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -119,3 +142,23 @@ func testAccOutscaleOAPILinConfigUpdateTags(value string) string {
 	   }
 `, value)
 }
+
+func testAccOutscaleOAPILinConfigDefaultTags(value string) string {
+	return fmt.Sprintf(`
+	provider "outscale" {
+		default_tags {
+			tags = {
+				cost-center = "terraform"
+			}
+		}
+	}
+
+	resource "outscale_net" "outscale_net" {
+		ip_range = "10.0.0.0/16"
+		tags {
+			key   = "name"
+			value = "%s"
+		}
+	}
+`, value)
+}
@@ -29,6 +29,7 @@ func resourceOutscaleOAPINic() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffOAPITagsAll,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
@@ -226,7 +227,8 @@ func getOAPINicSchema() map[string]*schema.Schema {
 			Type:     schema.TypeString,
 			Computed: true,
 		},
-		"tags": tagsListOAPISchema(),
+		"tags":     tagsListOAPISchema(),
+		"tags_all": tagsOAPIListSchemaComputed(),
 		"net_id": {
 			Type:     schema.TypeString,
 			Computed: true,
@@ -283,7 +285,7 @@ func resourceOutscaleOAPINicCreate(d *schema.ResourceData, meta interface{}) err
 	d.SetId(resp.Nic.GetNicId())
 
 	if d.IsNewResource() {
-		if err := setOSCAPITags(conn, d); err != nil {
+		if err := setOSCAPITags(meta.(*OutscaleClient), d); err != nil {
 			return err
 		}
 		d.SetPartial("tags")
@@ -445,7 +447,7 @@ func resourceOutscaleOAPINicRead(d *schema.ResourceData, meta interface{}) error
 	if err := d.Set("state", eni.GetState()); err != nil {
 		return err
 	}
-	if err := d.Set("tags", tagsOSCAPIToMap(eni.GetTags())); err != nil {
+	if err := setOSCAPITagsAttributes(d, meta.(*OutscaleClient), eni.GetTags()); err != nil {
 		return err
 	}
 	if err := d.Set("net_id", eni.GetNetId()); err != nil {
@@ -749,7 +751,7 @@ func resourceOutscaleOAPINicUpdate(d *schema.ResourceData, meta interface{}) err
 		d.SetPartial("description")
 	}
 
-	if err := setOSCAPITags(conn, d); err != nil {
+	if err := setOSCAPITags(meta.(*OutscaleClient), d); err != nil {
 		return err
 	}
 
@@ -23,6 +23,7 @@ func resourceOutscaleOAPIPublicIP() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffOAPITagsAll,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
@@ -51,11 +52,8 @@ func resourceOutscaleOAPIPublicIPCreate(d *schema.ResourceData, meta interface{}
 	d.SetId(allocResp.PublicIp.GetPublicIpId())
 
 	//SetTags
-	if tags, ok := d.GetOk("tags"); ok {
-		err := assignTags(tags.(*schema.Set), *allocResp.GetPublicIp().PublicIpId, conn)
-		if err != nil {
-			return err
-		}
+	if err := assignTags(d.Get("tags").(*schema.Set), *allocResp.GetPublicIp().PublicIpId, meta.(*OutscaleClient)); err != nil {
+		return err
 	}
 
 	log.Printf("[INFO] EIP ID: %s (placement: %v)", d.Id(), allocResp.GetPublicIp())
@@ -112,7 +110,7 @@ func resourceOutscaleOAPIPublicIPRead(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	if err := d.Set("tags", tagsOSCAPIToMap(publicIP.GetTags())); err != nil {
+	if err := setOSCAPITagsAttributes(d, meta.(*OutscaleClient), publicIP.GetTags()); err != nil {
 		log.Printf("[WARN] error setting tags for PublicIp(%s): %s", publicIP.GetPublicIp(), err)
 	}
 
@@ -170,7 +168,7 @@ func resourceOutscaleOAPIPublicIPUpdate(d *schema.ResourceData, meta interface{}
 	}
 	d.Partial(true)
 
-	if err := setOSCAPITags(conn, d); err != nil {
+	if err := setOSCAPITags(meta.(*OutscaleClient), d); err != nil {
 		return err
 	}
 
@@ -276,7 +274,8 @@ func getOAPIPublicIPSchema() map[string]*schema.Schema {
 			Type:     schema.TypeString,
 			Computed: true,
 		},
-		"tags": tagsListOAPISchema(),
+		"tags":     tagsListOAPISchema(),
+		"tags_all": tagsOAPIListSchemaComputed(),
 	}
 }
 
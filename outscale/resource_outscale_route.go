@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net"
 	"reflect"
 	"strings"
 	"time"
@@ -15,10 +16,11 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 )
 
 var errOAPIRoute = errors.New("Error: more than 1 target specified. Only 1 of gateway_id, " +
-	"nat_service_id, vm_id, nic_id or net_peering_id is allowed.")
+	"nat_service_id, vm_id, nic_id, net_peering_id or net_access_point_id is allowed.")
 
 var allowedTargets = []string{
 	"gateway_id",
@@ -26,6 +28,12 @@ var allowedTargets = []string{
 	"vm_id",
 	"nic_id",
 	"net_peering_id",
+	"net_access_point_id",
+}
+
+var allowedDestinations = []string{
+	"destination_ip_range",
+	"destination_service_id",
 }
 
 func resourceOutscaleOAPIRoute() *schema.Resource {
@@ -38,19 +46,28 @@ func resourceOutscaleOAPIRoute() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceOutscaleOAPIRouteImportState,
 		},
+		CustomizeDiff: resourceOutscaleOAPIRouteCustomizeDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+			Update: schema.DefaultTimeout(2 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"creation_method": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 			"destination_ip_range": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: allowedDestinations,
+				ValidateFunc: validation.IsCIDR,
 			},
 			"destination_service_id": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: allowedDestinations,
 			},
 			"gateway_id": {
 				Type:         schema.TypeString,
@@ -66,6 +83,12 @@ func resourceOutscaleOAPIRoute() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"net_access_point_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: allowedTargets,
+			},
 			"net_peering_id": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -82,6 +105,12 @@ func resourceOutscaleOAPIRoute() *schema.Resource {
 				Computed: true,
 			},
 			"await_active_state": {
+				Type:       schema.TypeBool,
+				Default:    true,
+				Optional:   true,
+				Deprecated: "Use wait_for_propagation instead",
+			},
+			"wait_for_propagation": {
 				Type:     schema.TypeBool,
 				Default:  true,
 				Optional: true,
@@ -111,12 +140,49 @@ func resourceOutscaleOAPIRoute() *schema.Resource {
 func resourceOutscaleOAPIRouteCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*OutscaleClient).OSCAPI
 	numTargets, target := getTarget(d)
-	awaitActiveState := d.Get("await_active_state").(bool)
 
 	if numTargets > 1 {
 		return errOAPIRoute
 	}
 
+	routeTableID := d.Get("route_table_id").(string)
+	destinationIPRange := d.Get("destination_ip_range").(string)
+	destinationServiceID := d.Get("destination_service_id").(string)
+
+	var err error
+	if target == "net_access_point_id" {
+		// The routes to a service are added by linking the Net access point to the route table.
+		err = updateOAPINetAccessPointRouteTables(conn, d.Get("net_access_point_id").(string), routeTableID, true)
+	} else {
+		err = createOAPIRoute(conn, d, target)
+	}
+	if err != nil {
+		return err
+	}
+
+	// Save the route before waiting for it, so that it is destroyed rather than left behind
+	// if it can't be found.
+	d.SetId(routeTableID)
+
+	var route *oscgo.Route
+	var requestID string
+
+	if waitForOAPIRoutePropagation(d) {
+		route, requestID, err = waitForOAPIRouteActive(conn, d, target, d.Timeout(schema.TimeoutCreate))
+	} else {
+		err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+			route, requestID, err = findResourceOAPIRouteDestination(conn, routeTableID, destinationIPRange, destinationServiceID)
+			return resource.RetryableError(err)
+		})
+	}
+	if err != nil {
+		return fmt.Errorf("Error finding route after creating it: %s", err)
+	}
+
+	return resourceOutscaleOAPIRouteSetResourceData(d, route, requestID)
+}
+
+func createOAPIRoute(conn *oscgo.APIClient, d *schema.ResourceData, target string) error {
 	createOpts := oscgo.CreateRouteRequest{
 		RouteTableId:       d.Get("route_table_id").(string),
 		DestinationIpRange: d.Get("destination_ip_range").(string),
@@ -153,34 +219,36 @@ func resourceOutscaleOAPIRouteCreate(d *schema.ResourceData, meta interface{}) e
 		return nil
 	})
 
-	var errString string
-
 	if err != nil {
-		errString = err.Error()
-
-		return fmt.Errorf("Error creating route: %s", errString)
+		return fmt.Errorf("Error creating route: %s", err.Error())
 	}
+	return nil
+}
 
-	var route *oscgo.Route
-	var requestID string
+// updateOAPINetAccessPointRouteTables links the Net access point to the route table, which
+// adds a route to its service in the table, or unlinks it.
+func updateOAPINetAccessPointRouteTables(conn *oscgo.APIClient, netAccessPointID, routeTableID string, link bool) error {
+	req := oscgo.UpdateNetAccessPointRequest{NetAccessPointId: netAccessPointID}
+	if link {
+		req.SetAddRouteTableIds([]string{routeTableID})
+	} else {
+		req.SetRemoveRouteTableIds([]string{routeTableID})
+	}
 
-	if v, ok := d.GetOk("destination_ip_range"); ok {
-		err = resource.Retry(2*time.Minute, func() *resource.RetryError {
-			route, requestID, err = findResourceOAPIRoute(conn, d.Get("route_table_id").(string), v.(string))
-			if awaitActiveState && err == nil {
-				if route.GetState() != "active" {
-					return resource.RetryableError(fmt.Errorf("still await route to be active"))
-				}
-			}
-			return resource.RetryableError(err)
-		})
+	var err error
+	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+		_, _, err = conn.NetAccessPointApi.UpdateNetAccessPoint(context.Background()).UpdateNetAccessPointRequest(req).Execute()
 		if err != nil {
-			return fmt.Errorf("Error finding route after creating it: %s", err)
+			if strings.Contains(fmt.Sprint(err), "RequestLimitExceeded") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
 		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error updating the route tables of the Net access point (%s): %s", netAccessPointID, utils.GetErrorResponse(err))
 	}
-
-	d.SetId(d.Get("route_table_id").(string))
-	resourceOutscaleOAPIRouteSetResourceData(d, route, requestID)
 	return nil
 }
 
@@ -189,9 +257,10 @@ func resourceOutscaleOAPIRouteRead(d *schema.ResourceData, meta interface{}) err
 	routeTableID := d.Id()
 
 	destinationIPRange := d.Get("destination_ip_range").(string)
+	destinationServiceID := d.Get("destination_service_id").(string)
 	var requestID string
 
-	route, requestID, err := findResourceOAPIRoute(conn, routeTableID, destinationIPRange)
+	route, requestID, err := findResourceOAPIRouteDestination(conn, routeTableID, destinationIPRange, destinationServiceID)
 	if err != nil {
 		if strings.Contains(fmt.Sprint(err), "InvalidRouteTableID.NotFound") {
 			log.Printf("[WARN] Route Table %q could not be found. Removing Route from state.", routeTableID)
@@ -215,6 +284,9 @@ func resourceOutscaleOAPIRouteSetResourceData(d *schema.ResourceData, route *osc
 	if err := d.Set("nat_access_point", route.GetNetAccessPointId()); err != nil {
 		return err
 	}
+	if err := d.Set("net_access_point_id", route.GetNetAccessPointId()); err != nil {
+		return err
+	}
 	if err := d.Set("nat_service_id", route.GetNatServiceId()); err != nil {
 		return err
 	}
@@ -234,7 +306,7 @@ func resourceOutscaleOAPIRouteSetResourceData(d *schema.ResourceData, route *osc
 		return err
 	}
 
-	return nil
+	return d.Set("request_id", requestID)
 }
 
 func getTarget(d *schema.ResourceData) (n int, target string) {
@@ -257,13 +329,13 @@ func resourceOutscaleOAPIRouteUpdate(d *schema.ResourceData, meta interface{}) e
 	ns := n.(map[string]interface{})
 
 	for k := range os {
-		if d.HasChange(k) && k != "await_active_state" {
+		if d.HasChange(k) && k != "await_active_state" && k != "wait_for_propagation" {
 			nothingToDo = false
 		}
 	}
 
 	for k := range ns {
-		if d.HasChange(k) && k != "await_active_state" {
+		if d.HasChange(k) && k != "await_active_state" && k != "wait_for_propagation" {
 			nothingToDo = false
 		}
 	}
@@ -346,12 +418,26 @@ func resourceOutscaleOAPIRouteUpdate(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("error updating route: %s", utils.GetErrorResponse(err))
 	}
 
+	if waitForOAPIRoutePropagation(d) {
+		if _, _, err := waitForOAPIRouteActive(conn, d, target, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for the route to be updated: %s", err)
+		}
+	}
+
 	return resourceOutscaleOAPIRouteRead(d, meta)
 }
 
 func resourceOutscaleOAPIRouteDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*OutscaleClient).OSCAPI
 
+	if v, ok := d.GetOk("destination_service_id"); ok && v.(string) != "" {
+		if err := updateOAPINetAccessPointRouteTables(conn, d.Get("net_access_point_id").(string), d.Get("route_table_id").(string), false); err != nil {
+			return err
+		}
+		d.SetId("")
+		return nil
+	}
+
 	deleteOpts := oscgo.DeleteRouteRequest{
 		RouteTableId: d.Get("route_table_id").(string),
 	}
@@ -426,11 +512,11 @@ func resourceOutscaleOAPIRouteExists(d *schema.ResourceData, meta interface{}) (
 		return false, nil
 	}
 
-	if v, ok := d.GetOk("destination_ip_range"); ok {
-		for _, route := range resp.GetRouteTables()[0].GetRoutes() {
-			if route.GetDestinationIpRange() != "" && route.GetDestinationIpRange() == v.(string) {
-				return true, nil
-			}
+	destinationIPRange := d.Get("destination_ip_range").(string)
+	destinationServiceID := d.Get("destination_service_id").(string)
+	for _, route := range resp.GetRouteTables()[0].GetRoutes() {
+		if isOAPIRouteDestination(route, destinationIPRange, destinationServiceID) {
+			return true, nil
 		}
 	}
 
@@ -438,6 +524,12 @@ func resourceOutscaleOAPIRouteExists(d *schema.ResourceData, meta interface{}) (
 }
 
 func findResourceOAPIRoute(conn *oscgo.APIClient, rtbid string, cidr string) (*oscgo.Route, string, error) {
+	return findResourceOAPIRouteDestination(conn, rtbid, cidr, "")
+}
+
+// findResourceOAPIRouteDestination finds the route of the route table rtbid to the IP range
+// cidr, or to the service serviceID.
+func findResourceOAPIRouteDestination(conn *oscgo.APIClient, rtbid, cidr, serviceID string) (*oscgo.Route, string, error) {
 	routeTableID := rtbid
 
 	findOpts := oscgo.ReadRouteTablesRequest{}
@@ -474,34 +566,143 @@ func findResourceOAPIRoute(conn *oscgo.APIClient, rtbid string, cidr string) (*o
 		return nil, requestID, fmt.Errorf("Route Table %q is gone, or route does not exist", routeTableID)
 	}
 
+	if cidr == "" && serviceID == "" {
+		return nil, requestID, fmt.Errorf("When trying to find a matching route for Route Table %q "+
+			"you need to specify a CIDR block or a service ID", rtbid)
+	}
+
+	for _, route := range (resp.GetRouteTables()[0]).GetRoutes() {
+		if isOAPIRouteDestination(route, cidr, serviceID) {
+			return &route, requestID, nil
+		}
+	}
+
+	return nil, requestID, &oapiRouteNotFoundError{routeTableID: rtbid, destination: cidr + serviceID}
+}
+
+// oapiRouteNotFoundError is returned when the route table has no route to the destination.
+type oapiRouteNotFoundError struct {
+	routeTableID string
+	destination  string
+}
+
+func (e *oapiRouteNotFoundError) Error() string {
+	return fmt.Sprintf("Unable to find matching route for Route Table (%s) and destination (%s).", e.routeTableID, e.destination)
+}
+
+func isOAPIRouteDestination(route oscgo.Route, cidr, serviceID string) bool {
 	if cidr != "" {
-		for _, route := range (resp.GetRouteTables()[0]).GetRoutes() {
-			if route.GetDestinationIpRange() != "" && route.GetDestinationIpRange() == cidr {
-				return &route, requestID, nil
+		return route.GetDestinationIpRange() == cidr
+	}
+	return serviceID != "" && route.GetDestinationServiceId() == serviceID
+}
+
+// oapiRouteTargetID returns the ID of the target of the route.
+func oapiRouteTargetID(route *oscgo.Route, target string) string {
+	switch target {
+	case "gateway_id":
+		return route.GetGatewayId()
+	case "nat_service_id":
+		return route.GetNatServiceId()
+	case "vm_id":
+		return route.GetVmId()
+	case "nic_id":
+		return route.GetNicId()
+	case "net_peering_id":
+		return route.GetNetPeeringId()
+	case "net_access_point_id":
+		return route.GetNetAccessPointId()
+	}
+	return ""
+}
+
+func waitForOAPIRoutePropagation(d *schema.ResourceData) bool {
+	return d.Get("wait_for_propagation").(bool) && d.Get("await_active_state").(bool)
+}
+
+// waitForOAPIRouteActive waits for the route to be listed in its route table, to point to its
+// target and to be active, that is for the target to be available.
+func waitForOAPIRouteActive(conn *oscgo.APIClient, d *schema.ResourceData, target string, timeout time.Duration) (*oscgo.Route, string, error) {
+	routeTableID := d.Get("route_table_id").(string)
+	destinationIPRange := d.Get("destination_ip_range").(string)
+	destinationServiceID := d.Get("destination_service_id").(string)
+	targetID := d.Get(target).(string)
+
+	var requestID string
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"propagating"},
+		Target:  []string{"active"},
+		Refresh: func() (interface{}, string, error) {
+			route, reqID, err := findResourceOAPIRouteDestination(conn, routeTableID, destinationIPRange, destinationServiceID)
+			if err != nil {
+				if _, ok := err.(*oapiRouteNotFoundError); ok {
+					return route, "propagating", nil
+				}
+				return nil, "", err
 			}
-		}
+			requestID = reqID
+			if oapiRouteTargetID(route, target) != targetID {
+				return route, "propagating", nil
+			}
+			// A route to a target which is not available drops the traffic, and only
+			// becomes active again when the target is fixed.
+			if route.GetState() == "blackhole" {
+				return nil, "", fmt.Errorf("the route is in the blackhole state: its target %s (%s) is not available", target, targetID)
+			}
+			return route, route.GetState(), nil
+		},
+		Timeout:    timeout,
+		MinTimeout: 3 * time.Second,
+	}
 
-		return nil, requestID, fmt.Errorf("Unable to find matching route for Route Table (%s) "+
-			"and destination CIDR block (%s).", rtbid, cidr)
+	route, err := stateConf.WaitForState()
+	if err != nil {
+		return nil, "", fmt.Errorf("error waiting for the route to %s%s to be active: %s", destinationIPRange, destinationServiceID, err)
 	}
+	return route.(*oscgo.Route), requestID, nil
+}
+
+// resourceOutscaleOAPIRouteCustomizeDiff rejects the routes which can't be created, or would
+// drop the traffic they match.
+func resourceOutscaleOAPIRouteCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	return validateOAPIRouteDestination(
+		diff.Get("destination_ip_range").(string),
+		diff.Get("destination_service_id").(string) != "" || !diff.NewValueKnown("destination_service_id"),
+		diff.Get("net_access_point_id").(string) != "" || !diff.NewValueKnown("net_access_point_id"),
+	)
+}
 
-	return nil, requestID, fmt.Errorf("When trying to find a matching route for Route Table %q "+
-		"you need to specify a CIDR block", rtbid)
+func validateOAPIRouteDestination(destinationIPRange string, hasDestinationService, hasNetAccessPoint bool) error {
+	if hasDestinationService && !hasNetAccessPoint {
+		return errors.New("a route to destination_service_id must target a net_access_point_id")
+	}
+	if hasNetAccessPoint && !hasDestinationService {
+		return errors.New("a route to a net_access_point_id must have a destination_service_id, " +
+			"a Net access point only routes the traffic to its service")
+	}
 
+	if destinationIPRange != "" {
+		_, network, err := net.ParseCIDR(destinationIPRange)
+		if err != nil {
+			return fmt.Errorf("destination_ip_range %q is not a valid CIDR: %s", destinationIPRange, err)
+		}
+		// The API stores the network address of the range, the route could not be found again.
+		if network.String() != destinationIPRange {
+			return fmt.Errorf("destination_ip_range %q must be a network address, use %q", destinationIPRange, network.String())
+		}
+	}
+	return nil
 }
 
 func resourceOutscaleOAPIRouteImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	conn := meta.(*OutscaleClient).OSCAPI
 
-	parts := strings.SplitN(d.Id(), "_", 2)
-	if len(parts) != 2 {
-		return nil, errors.New("import format error: to import a Outscale Route, use the format {route_table_id}_{destination_ip_range}")
+	routeTableID, destinationIPRange, destinationServiceID, err := parseOAPIRouteImportID(d.Id())
+	if err != nil {
+		return nil, err
 	}
 
-	routeTableID := parts[0]
-	destinationIPRange := parts[1]
-
-	_, _, err := findResourceOAPIRoute(conn, routeTableID, destinationIPRange)
+	_, _, err = findResourceOAPIRouteDestination(conn, routeTableID, destinationIPRange, destinationServiceID)
 	if err != nil {
 		if strings.Contains(fmt.Sprint(err), "InvalidRouteTableID.NotFound") {
 			log.Printf("[WARN] Route Table %q could not be found. Removing Route from state.", routeTableID)
@@ -516,8 +717,25 @@ func resourceOutscaleOAPIRouteImportState(d *schema.ResourceData, meta interface
 	if err := d.Set("destination_ip_range", destinationIPRange); err != nil {
 		return nil, fmt.Errorf("error setting `%s` for Outscale Route(%s): %s", "destination_ip_range", destinationIPRange, err)
 	}
+	if err := d.Set("destination_service_id", destinationServiceID); err != nil {
+		return nil, fmt.Errorf("error setting `%s` for Outscale Route(%s): %s", "destination_service_id", destinationServiceID, err)
+	}
 
 	d.SetId(routeTableID)
 
 	return []*schema.ResourceData{d}, nil
 }
+
+// parseOAPIRouteImportID splits an ID of the form {route_table_id}_{destination_ip_range} or
+// {route_table_id}_{destination_service_id}. The destination is an IP range when it is a CIDR,
+// and a service ID otherwise.
+func parseOAPIRouteImportID(id string) (routeTableID, destinationIPRange, destinationServiceID string, err error) {
+	parts := strings.SplitN(id, "_", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", "", errors.New("import format error: to import a Outscale Route, use the format {route_table_id}_{destination_ip_range} or {route_table_id}_{destination_service_id}")
+	}
+	if _, _, err := net.ParseCIDR(parts[1]); err == nil {
+		return parts[0], parts[1], "", nil
+	}
+	return parts[0], "", parts[1], nil
+}
@@ -22,6 +22,7 @@ func resourceOutscaleOAPIRouteTable() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffOAPITagsAll,
 		Schema: map[string]*schema.Schema{
 			"net_id": {
 				Type:     schema.TypeString,
@@ -37,7 +38,8 @@ func resourceOutscaleOAPIRouteTable() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsListOAPISchema(),
+			"tags":     tagsListOAPISchema(),
+			"tags_all": tagsOAPIListSchemaComputed(),
 
 			"route_propagating_virtual_gateways": {
 				Type:     schema.TypeList,
@@ -179,7 +181,7 @@ func resourceOutscaleOAPIRouteTableCreate(d *schema.ResourceData, meta interface
 	}
 
 	if d.IsNewResource() {
-		if err := setOSCAPITags(conn, d); err != nil {
+		if err := setOSCAPITags(meta.(*OutscaleClient), d); err != nil {
 			return err
 		}
 		d.SetPartial("tags")
@@ -224,7 +226,7 @@ func resourceOutscaleOAPIRouteTableRead(d *schema.ResourceData, meta interface{}
 	if err := d.Set("link_route_tables", setOSCAPILinkRouteTables(rt.GetLinkRouteTables())); err != nil {
 		return err
 	}
-	if err := d.Set("tags", tagsOSCAPIToMap(rt.GetTags())); err != nil {
+	if err := setOSCAPITagsAttributes(d, meta.(*OutscaleClient), rt.GetTags()); err != nil {
 		return err
 	}
 
@@ -232,11 +234,11 @@ func resourceOutscaleOAPIRouteTableRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceOutscaleOAPIRouteTableUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*OutscaleClient).OSCAPI
+	client := meta.(*OutscaleClient)
 
 	d.Partial(true)
 
-	if err := setOSCAPITags(conn, d); err != nil {
+	if err := setOSCAPITags(client, d); err != nil {
 		return err
 	}
 
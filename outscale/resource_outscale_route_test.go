@@ -2,6 +2,7 @@ package outscale
 
 import (
 	"fmt"
+	"os"
 	"regexp"
 	"strings"
 	"testing"
@@ -56,7 +57,7 @@ func TestAccOutscaleOAPIRoute_importBasic(t *testing.T) {
 				ImportStateIdFunc:       testAccCheckOutscaleOAPIRouteImportStateIDFunc(resourceName),
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"request_id", "await_active_state"},
+				ImportStateVerifyIgnore: []string{"request_id", "await_active_state", "wait_for_propagation"},
 			},
 		},
 	})
@@ -79,7 +80,7 @@ func TestAccOutscaleOAPIRoute_importWithNatService(t *testing.T) {
 				ImportStateIdFunc:       testAccCheckOutscaleOAPIRouteImportStateIDFunc(resourceName),
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"request_id", "await_active_state"},
+				ImportStateVerifyIgnore: []string{"request_id", "await_active_state", "wait_for_propagation"},
 			},
 		},
 	})
@@ -205,6 +206,91 @@ func TestAccOutscaleOAPIRoute_onlyOneTarget(t *testing.T) {
 	})
 }
 
+func TestAccOutscaleOAPIRoute_destinationService(t *testing.T) {
+	var route oscgo.Route
+	resourceName := "outscale_route.service"
+	serviceName := fmt.Sprintf("com.outscale.%s.api", os.Getenv("OUTSCALE_REGION"))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOAPIOutscaleRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOutscaleOAPIRouteDestinationServiceConfig(serviceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOutscaleOAPIRouteExists(resourceName, &route),
+					resource.TestCheckResourceAttrPair(resourceName, "net_access_point_id", "outscale_net_access_point.net_access_point", "net_access_point_id"),
+					resource.TestCheckResourceAttr(resourceName, "state", "active"),
+				),
+			},
+			{
+				ResourceName: resourceName,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources[resourceName]
+					return fmt.Sprintf("%s_%s", rs.Primary.ID, rs.Primary.Attributes["destination_service_id"]), nil
+				},
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"request_id", "await_active_state", "wait_for_propagation"},
+			},
+		},
+	})
+}
+
+func TestValidateOAPIRouteDestination(t *testing.T) {
+	cases := []struct {
+		destinationIPRange    string
+		hasDestinationService bool
+		hasNetAccessPoint     bool
+		valid                 bool
+	}{
+		{"10.0.0.0/16", false, false, true},
+		{"0.0.0.0/0", false, false, true},
+		{"", true, true, true},
+		{"", true, false, false},
+		{"10.0.0.0/16", false, true, false},
+		{"10.0.0.1/16", false, false, false},
+		{"10.0.0.0", false, false, false},
+	}
+	for _, c := range cases {
+		err := validateOAPIRouteDestination(c.destinationIPRange, c.hasDestinationService, c.hasNetAccessPoint)
+		if (err == nil) != c.valid {
+			t.Errorf("validateOAPIRouteDestination(%q, %t, %t): expected valid %t, got %v",
+				c.destinationIPRange, c.hasDestinationService, c.hasNetAccessPoint, c.valid, err)
+		}
+	}
+}
+
+func TestParseOAPIRouteImportID(t *testing.T) {
+	cases := []struct {
+		id                   string
+		routeTableID         string
+		destinationIPRange   string
+		destinationServiceID string
+		valid                bool
+	}{
+		{"rtb-12345678_10.0.0.0/0", "rtb-12345678", "10.0.0.0/0", "", true},
+		{"rtb-12345678_pl-12345678", "rtb-12345678", "", "pl-12345678", true},
+		{"rtb-12345678_12345678", "rtb-12345678", "", "12345678", true},
+		{"rtb-12345678", "", "", "", false},
+		{"rtb-12345678_", "", "", "", false},
+		{"_10.0.0.0/0", "", "", "", false},
+	}
+	for _, c := range cases {
+		routeTableID, destinationIPRange, destinationServiceID, err := parseOAPIRouteImportID(c.id)
+		if (err == nil) != c.valid {
+			t.Errorf("parseOAPIRouteImportID(%q): expected valid %t, got %v", c.id, c.valid, err)
+			continue
+		}
+		if routeTableID != c.routeTableID || destinationIPRange != c.destinationIPRange || destinationServiceID != c.destinationServiceID {
+			t.Errorf("parseOAPIRouteImportID(%q): expected (%q, %q, %q), got (%q, %q, %q)", c.id,
+				c.routeTableID, c.destinationIPRange, c.destinationServiceID,
+				routeTableID, destinationIPRange, destinationServiceID)
+		}
+	}
+}
+
 func testAccCheckOutscaleOAPIRouteImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
 	return func(s *terraform.State) (string, error) {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -227,10 +313,11 @@ func testAccCheckOutscaleOAPIRouteExists(n string, res *oscgo.Route) resource.Te
 		}
 
 		conn := testAccProvider.Meta().(*OutscaleClient).OSCAPI
-		r, _, err := findResourceOAPIRoute(
+		r, _, err := findResourceOAPIRouteDestination(
 			conn,
 			rs.Primary.Attributes["route_table_id"],
 			rs.Primary.Attributes["destination_ip_range"],
+			rs.Primary.Attributes["destination_service_id"],
 		)
 
 		if err != nil {
@@ -254,10 +341,11 @@ func testAccCheckOAPIOutscaleRouteDestroy(s *terraform.State) error {
 		}
 
 		conn := testAccProvider.Meta().(*OutscaleClient).OSCAPI
-		route, _, err := findResourceOAPIRoute(
+		route, _, err := findResourceOAPIRouteDestination(
 			conn,
 			rs.Primary.Attributes["route_table_id"],
 			rs.Primary.Attributes["destination_ip_range"],
+			rs.Primary.Attributes["destination_service_id"],
 		)
 
 		if route == nil && err == nil {
@@ -364,6 +452,40 @@ var testAccOutscaleOAPIRouteWithNatService = fmt.Sprint(`
 	}
 `)
 
+func testAccOutscaleOAPIRouteDestinationServiceConfig(serviceName string) string {
+	return fmt.Sprintf(`
+		resource "outscale_net" "net" {
+			ip_range = "10.0.0.0/16"
+		}
+
+		resource "outscale_route_table" "route_table" {
+			net_id = outscale_net.net.net_id
+		}
+
+		resource "outscale_net_access_point" "net_access_point" {
+			net_id       = outscale_net.net.net_id
+			service_name = "%s"
+
+			lifecycle {
+				ignore_changes = [route_table_ids]
+			}
+		}
+
+		data "outscale_net_access_point_services" "services" {
+			filter {
+				name   = "service_names"
+				values = ["%s"]
+			}
+		}
+
+		resource "outscale_route" "service" {
+			destination_service_id = data.outscale_net_access_point_services.services.services[0].service_id
+			net_access_point_id    = outscale_net_access_point.net_access_point.net_access_point_id
+			route_table_id         = outscale_route_table.route_table.route_table_id
+		}
+	`, serviceName, serviceName)
+}
+
 func computeConfigTestChangeTarget(targets []string) string {
 	var extra_configs []string
 	for _, target := range targets {
@@ -22,6 +22,7 @@ func resourceOutscaleOAPISecurityGroup() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceOutscaleOAPISecurityGroupCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"description": {
@@ -56,14 +57,26 @@ func resourceOutscaleOAPISecurityGroup() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"remove_default_outbound_rule": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"exclusive_rules": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"inbound_rules":  getOAPIIPPerms(),
 			"outbound_rules": getOAPIIPPerms(),
 			"account_id": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsListOAPISchema(),
-			"tag":  tagsSchema(),
+			"tags":     tagsListOAPISchema(),
+			"tags_all": tagsOAPIListSchemaComputed(),
+			"tag":      tagsSchema(),
 			"request_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -72,41 +85,73 @@ func resourceOutscaleOAPISecurityGroup() *schema.Resource {
 	}
 }
 
+// getOAPIIPPerms returns the schema of the rules of a security group, which can only be set
+// when exclusive_rules is true.
 func getOAPIIPPerms() *schema.Schema {
 	return &schema.Schema{
-		Type:     schema.TypeList,
+		Type:     schema.TypeSet,
+		Optional: true,
 		Computed: true,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"from_port_range": {
 					Type:     schema.TypeInt,
-					Computed: true,
+					Optional: true,
 				},
 				"to_port_range": {
 					Type:     schema.TypeInt,
-					Computed: true,
+					Optional: true,
 				},
 				"ip_protocol": {
 					Type:     schema.TypeString,
-					Computed: true,
+					Optional: true,
+					Default:  "-1",
 				},
 				"ip_ranges": {
 					Type:     schema.TypeList,
-					Computed: true,
-					Elem: &schema.Schema{
-						Type: schema.TypeString,
-					},
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"service_ids": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
 				},
 				"security_groups_members": {
 					Type:     schema.TypeList,
 					Optional: true,
-					Elem:     &schema.Schema{Type: schema.TypeMap},
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"account_id": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"security_group_id": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"security_group_name": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+						},
+					},
 				},
 			},
 		},
 	}
 }
 
+// resourceOutscaleOAPISecurityGroupCustomizeDiff rejects the rules set inline when they are
+// not managed exclusively by the security group, they would never be applied.
+func resourceOutscaleOAPISecurityGroupCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.Get("exclusive_rules").(bool) && (diff.HasChange("inbound_rules") || diff.HasChange("outbound_rules")) {
+		return fmt.Errorf("inbound_rules and outbound_rules can only be set when exclusive_rules is true, " +
+			"use outscale_security_group_rule resources otherwise")
+	}
+	return customizeDiffOAPITagsAll(diff, meta)
+}
+
 func resourceOutscaleOAPISecurityGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*OutscaleClient).OSCAPI
 
@@ -176,12 +221,32 @@ func resourceOutscaleOAPISecurityGroupCreate(d *schema.ResourceData, meta interf
 	}
 
 	if d.IsNewResource() {
-		if err := setOSCAPITags(conn, d); err != nil {
+		if err := setOSCAPITags(meta.(*OutscaleClient), d); err != nil {
 			return err
 		}
 		d.SetPartial("tags")
 	}
 
+	if d.Get("remove_default_outbound_rule").(bool) {
+		if err := removeOutscaleOAPISecurityGroupDefaultOutboundRule(conn, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	if d.Get("exclusive_rules").(bool) {
+		// Only the flows whose rules are set in the configuration are managed, the other
+		// ones keep the rules of the new security group.
+		var keys []string
+		for _, k := range []string{"inbound_rules", "outbound_rules"} {
+			if _, ok := d.GetOkExists(k); ok {
+				keys = append(keys, k)
+			}
+		}
+		if err := updateOutscaleOAPISecurityGroupRules(conn, d, keys); err != nil {
+			return err
+		}
+	}
+
 	return resourceOutscaleOAPISecurityGroupRead(d, meta)
 }
 
@@ -214,17 +279,27 @@ func resourceOutscaleOAPISecurityGroupRead(d *schema.ResourceData, meta interfac
 	if err := d.Set("account_id", sg.GetAccountId()); err != nil {
 		return err
 	}
-	if err := d.Set("tags", tagsOSCAPIToMap(sg.GetTags())); err != nil {
+	if err := setOSCAPITagsAttributes(d, meta.(*OutscaleClient), sg.GetTags()); err != nil {
 		return err
 	}
 
-	if err := d.Set("inbound_rules", flattenOAPISecurityGroupRule(sg.GetInboundRules())); err != nil {
+	if err := setOutscaleOAPISecurityGroupRules(d, "inbound_rules", sg.GetInboundRules()); err != nil {
 		return err
 	}
 
 	d.SetId(sg.GetSecurityGroupId())
 
-	return d.Set("outbound_rules", flattenOAPISecurityGroupRule(sg.GetOutboundRules()))
+	return setOutscaleOAPISecurityGroupRules(d, "outbound_rules", sg.GetOutboundRules())
+}
+
+// setOutscaleOAPISecurityGroupRules sets the rules of the security group, unless the rules in
+// the state grant the same permissions: the API groups them by protocol and ports, which would
+// show a difference with rules grouped otherwise in the configuration.
+func setOutscaleOAPISecurityGroupRules(d *schema.ResourceData, key string, rules []oscgo.SecurityGroupRule) error {
+	if sameSecurityGroupPermissions(expandSecurityGroupRules(d.Get(key).(*schema.Set).List()), rules) {
+		return nil
+	}
+	return d.Set(key, flattenRules(rules))
 }
 
 func resourceOutscaleOAPISecurityGroupDelete(d *schema.ResourceData, meta interface{}) error {
@@ -263,20 +338,103 @@ func SGOAPIStateRefreshFunc(conn *oscgo.APIClient, id string) resource.StateRefr
 }
 
 func resourceOutscaleOAPISecurityGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*OutscaleClient).OSCAPI
+	client := meta.(*OutscaleClient)
 
 	d.Partial(true)
 
-	if err := setOSCAPITags(conn, d); err != nil {
+	if err := setOSCAPITags(client, d); err != nil {
 		return err
 	}
 
 	d.SetPartial("tags")
 
+	if d.Get("exclusive_rules").(bool) {
+		// Unset rules keep their value from the state and never change, so the flows they
+		// belong to are left as they are, like in Create.
+		var keys []string
+		for _, k := range []string{"inbound_rules", "outbound_rules"} {
+			if d.HasChange(k) {
+				keys = append(keys, k)
+			}
+		}
+		if err := updateOutscaleOAPISecurityGroupRules(client.OSCAPI, d, keys); err != nil {
+			return err
+		}
+	}
+
 	d.Partial(false)
 	return resourceOutscaleOAPISecurityGroupRead(d, meta)
 }
 
+// defaultOutboundPermission is the permission of the outbound rule allowing all the traffic,
+// added to the security groups created in a Net.
+var defaultOutboundPermission = securityGroupPermission{ipProtocol: "-1", ipRange: "0.0.0.0/0"}
+
+// removeOutscaleOAPISecurityGroupDefaultOutboundRule removes the default outbound rule of the
+// security group securityGroupID, and waits for the rule to be gone so the state read next
+// doesn't contain it.
+func removeOutscaleOAPISecurityGroupDefaultOutboundRule(conn *oscgo.APIClient, securityGroupID string) error {
+	hasDefaultOutboundRule := func() (bool, error) {
+		sg, _, err := readSecurityGroups(conn, securityGroupID)
+		if err != nil {
+			return false, err
+		}
+		return hasSecurityGroupPermission(expandSecurityGroupPermissions(sg.GetOutboundRules()), defaultOutboundPermission), nil
+	}
+
+	found, err := hasDefaultOutboundRule()
+	if err != nil || !found {
+		return err
+	}
+
+	log.Printf("[DEBUG] Removing the default outbound rule of the Security Group (%s)", securityGroupID)
+	if err := updateSecurityGroupPermissions(conn, securityGroupID, "Outbound", nil, []securityGroupPermission{defaultOutboundPermission}); err != nil {
+		return err
+	}
+
+	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+		found, err := hasDefaultOutboundRule()
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if found {
+			return resource.RetryableError(fmt.Errorf("the default outbound rule of the Security Group (%s) is still present", securityGroupID))
+		}
+		return nil
+	})
+}
+
+// updateOutscaleOAPISecurityGroupRules adds and removes rules so the security group grants
+// exactly the permissions of the rules in keys (inbound_rules and/or outbound_rules), whoever
+// added the current ones.
+func updateOutscaleOAPISecurityGroupRules(conn *oscgo.APIClient, d *schema.ResourceData, keys []string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	sg, _, err := readSecurityGroups(conn, d.Id())
+	if err != nil {
+		return err
+	}
+
+	flows := map[string]struct {
+		flow    string
+		current []oscgo.SecurityGroupRule
+	}{
+		"inbound_rules":  {"Inbound", sg.GetInboundRules()},
+		"outbound_rules": {"Outbound", sg.GetOutboundRules()},
+	}
+	for _, key := range keys {
+		f := flows[key]
+		wanted := expandSecurityGroupRules(d.Get(key).(*schema.Set).List())
+		added, removed := diffSecurityGroupPermissions(expandSecurityGroupPermissions(f.current), expandSecurityGroupPermissions(wanted))
+		log.Printf("[DEBUG] Security Group (%s) %s rules: adding %d and removing %d permissions", d.Id(), f.flow, len(added), len(removed))
+		if err := updateSecurityGroupPermissions(conn, d.Id(), f.flow, added, removed); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func readSecurityGroups(client *oscgo.APIClient, securityGroupID string) (*oscgo.SecurityGroup, *oscgo.ReadSecurityGroupsResponse, error) {
 	filters := oscgo.ReadSecurityGroupsRequest{
 		Filters: &oscgo.FiltersSecurityGroup{
@@ -22,6 +22,7 @@ func resourceOutscaleOAPIOutboundRule() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceOutscaleOAPIOutboundRuleCreate,
 		Read:   resourceOutscaleOAPIOutboundRuleRead,
+		Update: resourceOutscaleOAPIOutboundRuleUpdate,
 		Delete: resourceOutscaleOAPIOutboundRuleDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceOutscaleOAPISecurityGroupRuleImportState,
@@ -36,24 +37,20 @@ func resourceOutscaleOAPIOutboundRule() *schema.Resource {
 			"from_port_range": {
 				Type:     schema.TypeInt,
 				Optional: true,
-				ForceNew: true,
 			},
 			"ip_protocol": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ForceNew:      true,
 				ConflictsWith: []string{"rules", "security_group_name_to_link"},
 			},
 			"ip_range": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 			},
 			"rules": getRulesSchema(false),
 			"security_group_account_id_to_link": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 			},
 			"security_group_id": {
 				Type:     schema.TypeString,
@@ -63,13 +60,11 @@ func resourceOutscaleOAPIOutboundRule() *schema.Resource {
 			"security_group_name_to_link": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ForceNew:      true,
 				ConflictsWith: []string{"ip_protocol", "rules"},
 			},
 			"to_port_range": {
 				Type:     schema.TypeInt,
 				Optional: true,
-				ForceNew: true,
 			},
 			"security_group_name": {
 				Type:     schema.TypeString,
@@ -110,12 +105,6 @@ func resourceOutscaleOAPIOutboundRuleCreate(d *schema.ResourceData, meta interfa
 	if v, ok := d.GetOk("ip_range"); ok {
 		req.IpRange = pointy.String(v.(string))
 	}
-	if v, ok := d.GetOk("security_group_account_id_to_link"); ok {
-		req.SecurityGroupAccountIdToLink = pointy.String(v.(string))
-	}
-	if v, ok := d.GetOk("security_group_name_to_link"); ok {
-		req.SecurityGroupNameToLink = pointy.String(v.(string))
-	}
 
 	var err error
 	var resp oscgo.CreateSecurityGroupRuleResponse
@@ -164,6 +153,70 @@ func resourceOutscaleOAPIOutboundRuleRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
+// resourceOutscaleOAPIOutboundRuleUpdate only adds and removes the permissions which changed,
+// the new ones first so the traffic they both allow is never dropped.
+func resourceOutscaleOAPIOutboundRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*OutscaleClient).OSCAPI
+
+	oldPermissions := securityGroupRulePermissions(func(key string) interface{} {
+		o, _ := d.GetChange(key)
+		return o
+	})
+	added, removed := diffSecurityGroupPermissions(oldPermissions, securityGroupRulePermissions(d.Get))
+
+	if err := updateSecurityGroupPermissions(conn, d.Get("security_group_id").(string), d.Get("flow").(string), added, removed); err != nil {
+		return err
+	}
+
+	return resourceOutscaleOAPIOutboundRuleRead(d, meta)
+}
+
+// securityGroupRulePermissions returns the permissions of the rules and of the top level
+// arguments of the resource, read with get.
+func securityGroupRulePermissions(get func(string) interface{}) []securityGroupPermission {
+	permissions := expandSecurityGroupPermissions(expandSecurityGroupRules(get("rules").([]interface{})))
+
+	if ipRange := get("ip_range").(string); ipRange != "" {
+		permission := securityGroupRuleBasePermission(get)
+		permission.ipRange = ipRange
+		permissions = append(permissions, permission)
+	}
+	if permission, ok := securityGroupRuleLinkPermission(get); ok {
+		permissions = append(permissions, permission)
+	}
+	return permissions
+}
+
+func securityGroupRuleBasePermission(get func(string) interface{}) securityGroupPermission {
+	base := securityGroupPermission{
+		ipProtocol:    get("ip_protocol").(string),
+		fromPortRange: int32(get("from_port_range").(int)),
+		toPortRange:   int32(get("to_port_range").(int)),
+	}
+	if base.ipProtocol == "" {
+		base.ipProtocol = "-1"
+	}
+	return base
+}
+
+// securityGroupRuleLinkPermission returns the permission of the security group given by
+// security_group_name_to_link, by ID when it starts with sg- and by name otherwise. Create,
+// Update and Delete all use it, so they agree on the permission.
+func securityGroupRuleLinkPermission(get func(string) interface{}) (securityGroupPermission, bool) {
+	name := get("security_group_name_to_link").(string)
+	if name == "" {
+		return securityGroupPermission{}, false
+	}
+	permission := securityGroupRuleBasePermission(get)
+	permission.accountID = get("security_group_account_id_to_link").(string)
+	if strings.HasPrefix(name, "sg-") {
+		permission.securityGroupID = name
+	} else {
+		permission.securityGroupName = name
+	}
+	return permission, true
+}
+
 func resourceOutscaleOAPIOutboundRuleDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*OutscaleClient).OSCAPI
 
@@ -204,36 +257,45 @@ func resourceOutscaleOAPIOutboundRuleDelete(d *schema.ResourceData, meta interfa
 	return nil
 }
 
+// expandRules returns the rules of the resource, with the security group to link.
 func expandRules(d *schema.ResourceData) *[]oscgo.SecurityGroupRule {
-	if len(d.Get("rules").([]interface{})) > 0 {
-		rules := make([]oscgo.SecurityGroupRule, len(d.Get("rules").([]interface{})))
+	rules := expandSecurityGroupRules(d.Get("rules").([]interface{}))
+	if permission, ok := securityGroupRuleLinkPermission(d.Get); ok {
+		rules = append(rules, permission.rule())
+	}
+	if len(rules) > 0 {
+		return &rules
+	}
+	return nil
+}
 
-		for i, rule := range d.Get("rules").([]interface{}) {
-			r := rule.(map[string]interface{})
+func expandSecurityGroupRules(securityGroupRules []interface{}) []oscgo.SecurityGroupRule {
+	rules := make([]oscgo.SecurityGroupRule, len(securityGroupRules))
 
-			rules[i] = oscgo.SecurityGroupRule{
-				SecurityGroupsMembers: expandSecurityGroupsMembers(r["security_groups_members"].([]interface{})),
-			}
+	for i, rule := range securityGroupRules {
+		r := rule.(map[string]interface{})
 
-			if ipRanges := expandStringValueListPointer(r["ip_ranges"].([]interface{})); len(*ipRanges) > 0 {
-				rules[i].IpRanges = expandStringValueListPointer(r["ip_ranges"].([]interface{}))
-			}
-			if serviceIDs := expandStringValueListPointer(r["service_ids"].([]interface{})); len(*serviceIDs) > 0 {
-				rules[i].ServiceIds = expandStringValueListPointer(r["service_ids"].([]interface{}))
-			}
-			if v, ok := r["from_port_range"]; ok {
-				rules[i].FromPortRange = pointy.Int32(cast.ToInt32(v))
-			}
-			if v, ok := r["ip_protocol"]; ok && v != "" {
-				rules[i].IpProtocol = pointy.String(cast.ToString(v))
-			}
-			if v, ok := r["to_port_range"]; ok {
-				rules[i].ToPortRange = pointy.Int32(cast.ToInt32(v))
-			}
+		rules[i] = oscgo.SecurityGroupRule{
+			SecurityGroupsMembers: expandSecurityGroupsMembers(r["security_groups_members"].([]interface{})),
+		}
+
+		if ipRanges := expandStringValueListPointer(r["ip_ranges"].([]interface{})); len(*ipRanges) > 0 {
+			rules[i].IpRanges = ipRanges
+		}
+		if serviceIDs := expandStringValueListPointer(r["service_ids"].([]interface{})); len(*serviceIDs) > 0 {
+			rules[i].ServiceIds = serviceIDs
+		}
+		if v, ok := r["from_port_range"]; ok {
+			rules[i].FromPortRange = pointy.Int32(cast.ToInt32(v))
+		}
+		if v, ok := r["ip_protocol"]; ok && v != "" {
+			rules[i].IpProtocol = pointy.String(cast.ToString(v))
+		}
+		if v, ok := r["to_port_range"]; ok {
+			rules[i].ToPortRange = pointy.Int32(cast.ToInt32(v))
 		}
-		return &rules
 	}
-	return nil
+	return rules
 }
 
 func flattenRules(securityGroupsRules []oscgo.SecurityGroupRule) []map[string]interface{} {
@@ -290,7 +352,6 @@ func getRulesSchema(isForAttr bool) *schema.Schema {
 		Type:          schema.TypeList,
 		Optional:      true,
 		Computed:      isForAttr,
-		ForceNew:      !isForAttr,
 		ConflictsWith: []string{"ip_protocol", "security_group_name_to_link"},
 
 		Elem: &schema.Resource{
@@ -298,33 +359,28 @@ func getRulesSchema(isForAttr bool) *schema.Schema {
 				"from_port_range": {
 					Type:     schema.TypeInt,
 					Optional: !isForAttr,
-					ForceNew: !isForAttr,
 					Computed: isForAttr,
 				},
 				"ip_protocol": {
 					Type:     schema.TypeString,
 					Optional: true,
 					Computed: !isForAttr,
-					ForceNew: !isForAttr,
 				},
 				"ip_ranges": {
 					Type:     schema.TypeList,
 					Optional: !isForAttr,
-					ForceNew: !isForAttr,
 					Computed: isForAttr,
 					Elem:     &schema.Schema{Type: schema.TypeString},
 				},
 				"service_ids": {
 					Type:     schema.TypeList,
 					Optional: !isForAttr,
-					ForceNew: !isForAttr,
 					Computed: isForAttr,
 					Elem:     &schema.Schema{Type: schema.TypeString},
 				},
 				"to_port_range": {
 					Type:     schema.TypeInt,
 					Optional: !isForAttr,
-					ForceNew: !isForAttr,
 					Computed: isForAttr,
 				},
 				"security_groups_members": {
@@ -336,19 +392,16 @@ func getRulesSchema(isForAttr bool) *schema.Schema {
 							"account_id": {
 								Type:     schema.TypeString,
 								Optional: !isForAttr,
-								ForceNew: !isForAttr,
 								Computed: isForAttr,
 							},
 							"security_group_id": {
 								Type:     schema.TypeString,
 								Optional: !isForAttr,
-								ForceNew: !isForAttr,
 								Computed: isForAttr,
 							},
 							"security_group_name": {
 								Type:     schema.TypeString,
 								Optional: !isForAttr,
-								ForceNew: !isForAttr,
 								Computed: isForAttr,
 							},
 						},
@@ -362,12 +415,13 @@ func getRulesSchema(isForAttr bool) *schema.Schema {
 func resourceOutscaleOAPISecurityGroupRuleImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	// example: sg-53173ec7_inbound_tcp_80_80_80.14.129.222/32
 	// example: sg-53173ec7_inbound_tcp_80_80_sg-53173ec7
+	// example: sg-53173ec7_outbound_-1_-1_-1_pl-53173ec7
 
 	conn := meta.(*OutscaleClient).OSCAPI
 
 	parts := strings.SplitN(d.Id(), "_", 6)
 	if len(parts) != 6 {
-		return nil, errors.New("import format error: to import a Outscale Security Group Rule, use the format {id}_{flow}_{protocol}_{fromPort}_{toPort}_{ip range or id}")
+		return nil, errors.New("import format error: to import a Outscale Security Group Rule, use the format {id}_{flow}_{protocol}_{fromPort}_{toPort}_{ip range, security group ID or service ID}")
 	}
 
 	sgID := parts[0]
@@ -375,8 +429,7 @@ func resourceOutscaleOAPISecurityGroupRuleImportState(d *schema.ResourceData, me
 	protocol := parts[2]
 	fromPort := parts[3]
 	toPort := parts[4]
-	sources := parts[5]
-	sourcesValidation := parts[5:]
+	source := parts[5]
 
 	//Validations
 	if !strings.EqualFold(ruleType, "inbound") && !strings.EqualFold(ruleType, "outbound") {
@@ -395,117 +448,80 @@ func resourceOutscaleOAPISecurityGroupRuleImportState(d *schema.ResourceData, me
 		return nil, errors.New("invalid to port")
 	}
 
-	isSGID := false
-	for _, source := range sourcesValidation {
-		// will be properly validated later
-		if !strings.Contains(source, "sg-") && !strings.Contains(source, "pl-") && !strings.Contains(source, ":") && !strings.Contains(source, ".") {
-			return nil, errors.New("source must be cidr, ipv6cidr, or a sg ID")
-		}
-
-		if strings.Contains(source, "sg-") || strings.Contains(source, "pl-") {
-			isSGID = true
-		}
+	// The source is validated by looking for the permission in the security group.
+	permission := securityGroupPermission{
+		ipProtocol:    protocol,
+		fromPortRange: cast.ToInt32(fromPort),
+		toPortRange:   cast.ToInt32(toPort),
+	}
+	switch {
+	case strings.HasPrefix(source, "sg-"):
+		permission.securityGroupID = source
+	case strings.HasPrefix(source, "pl-"):
+		permission.serviceID = source
+	case strings.Contains(source, ":") || strings.Contains(source, "."):
+		permission.ipRange = source
+	default:
+		return nil, errors.New("source must be cidr, ipv6cidr, a sg ID or a service ID")
 	}
 
-	filter := &oscgo.FiltersSecurityGroup{
+	sg, resp, err := readSecurityGroupsWithFilter(conn, &oscgo.FiltersSecurityGroup{
 		SecurityGroupIds: &[]string{sgID},
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	if strings.EqualFold(ruleType, "inbound") {
-		filter.InboundRuleProtocols = &[]string{protocol}
-		filter.InboundRuleFromPortRanges = &[]int32{cast.ToInt32(fromPort)}
-		filter.InboundRuleToPortRanges = &[]int32{cast.ToInt32(toPort)}
-		if isSGID {
-			filter.InboundRuleSecurityGroupIds = &[]string{sources}
-		} else {
-			filter.InboundRuleIpRanges = &[]string{sources}
-		}
-	}
+	flow, rules := "Inbound", sg.GetInboundRules()
 	if strings.EqualFold(ruleType, "outbound") {
-		filter.OutboundRuleProtocols = &[]string{protocol}
-		filter.OutboundRuleFromPortRanges = &[]int32{cast.ToInt32(fromPort)}
-		filter.OutboundRuleToPortRanges = &[]int32{cast.ToInt32(toPort)}
-		if isSGID {
-			filter.OutboundRuleSecurityGroupIds = &[]string{sources}
-		} else {
-			filter.OutboundRuleIpRanges = &[]string{sources}
-		}
+		flow, rules = "Outbound", sg.GetOutboundRules()
 	}
-
-	sg, resp, err := readSecurityGroupsWithFilter(conn, filter)
-	if err != nil {
-		return nil, err
+	if !hasSecurityGroupPermission(expandSecurityGroupPermissions(rules), permission) {
+		return nil, fmt.Errorf("no %s permission %s found in the Outscale Security Group(%s)", strings.ToLower(flow), d.Id(), sgID)
 	}
 
-	resultInbound := []oscgo.SecurityGroupRule{}
-	resultOutbound := []oscgo.SecurityGroupRule{}
-
-	var ipRange, ipProtocol, fromRange, toRange string
-
-	if strings.EqualFold(ruleType, "inbound") {
-		for _, inbound := range sg.GetInboundRules() {
-			if inbound.GetIpProtocol() == protocol && inbound.GetFromPortRange() == cast.ToInt32(fromPort) && inbound.GetToPortRange() == cast.ToInt32(toPort) {
-				for _, ip := range inbound.GetIpRanges() {
-					if ip == sources {
-						ipRange = ip
-						ipProtocol = protocol
-						fromRange = fromPort
-						toRange = toPort
-						resultInbound = append(resultInbound, inbound)
-					}
-				}
-			}
+	// The permissions from an IP range are imported as the top level arguments, the others as
+	// a rule with a single permission.
+	if permission.ipRange != "" {
+		if err := d.Set("from_port_range", permission.fromPortRange); err != nil {
+			return nil, fmt.Errorf("error setting `from_port_range` for Outscale Security Group Rule(%s): %s", d.Id(), err)
 		}
-	}
-
-	if strings.EqualFold(ruleType, "outbound") {
-		for _, outbound := range sg.GetOutboundRules() {
-			if outbound.GetIpProtocol() == protocol && outbound.GetFromPortRange() == cast.ToInt32(fromPort) && outbound.GetToPortRange() == cast.ToInt32(toPort) {
-				for _, ip := range outbound.GetIpRanges() {
-					if ip == sources {
-						ipRange = ip
-						ipProtocol = protocol
-						fromRange = fromPort
-						toRange = toPort
-						resultOutbound = append(resultOutbound, outbound)
-					}
-				}
-			}
+		if err := d.Set("to_port_range", permission.toPortRange); err != nil {
+			return nil, fmt.Errorf("error setting `to_port_range` for Outscale Security Group Rule(%s): %s", d.Id(), err)
+		}
+		if err := d.Set("ip_protocol", permission.ipProtocol); err != nil {
+			return nil, fmt.Errorf("error setting `ip_protocol` for Outscale Security Group Rule(%s): %s", d.Id(), err)
+		}
+		if err := d.Set("ip_range", permission.ipRange); err != nil {
+			return nil, fmt.Errorf("error setting `ip_range` for Outscale Security Group Rule(%s): %s", d.Id(), err)
+		}
+	} else {
+		rule := map[string]interface{}{
+			"from_port_range":         permission.fromPortRange,
+			"to_port_range":           permission.toPortRange,
+			"ip_protocol":             permission.ipProtocol,
+			"ip_ranges":               []string{},
+			"service_ids":             []string{},
+			"security_groups_members": []map[string]interface{}{},
+		}
+		if permission.serviceID != "" {
+			rule["service_ids"] = []string{permission.serviceID}
+		} else {
+			rule["security_groups_members"] = []map[string]interface{}{{"security_group_id": permission.securityGroupID}}
+		}
+		if err := d.Set("rules", []map[string]interface{}{rule}); err != nil {
+			return nil, fmt.Errorf("error setting `rules` for Outscale Security Group Rule(%s): %s", d.Id(), err)
 		}
 	}
 
-	if err := d.Set("inbound_rules", flattenRules(resultInbound)); err != nil {
-		return nil, fmt.Errorf("error setting `inbound_rules` for Outscale Security Group Rule(%s): %s", d.Id(), err)
-	}
-	if err := d.Set("outbound_rules", flattenRules(resultOutbound)); err != nil {
-		return nil, fmt.Errorf("error setting `outbound_rules` for Outscale Security Group Rule(%s): %s", d.Id(), err)
-	}
 	if err := d.Set("security_group_name", sg.GetSecurityGroupName()); err != nil {
 		return nil, fmt.Errorf("error setting `security_group_name` for Outscale Security Group Rule(%s): %s", d.Id(), err)
 	}
 	if err := d.Set("net_id", sg.GetNetId()); err != nil {
 		return nil, fmt.Errorf("error setting `net_id` for Outscale Security Group Rule(%s): %s", d.Id(), err)
 	}
-	if ruleType == "inbound" {
-		if err := d.Set("flow", "Inbound"); err != nil {
-			return nil, fmt.Errorf("error setting `flow` for Outscale Security Group Rule(%s): %s", d.Id(), err)
-		}
-	} else {
-		if err := d.Set("flow", "Outbound"); err != nil {
-			return nil, fmt.Errorf("error setting `flow` for Outscale Security Group Rule(%s): %s", d.Id(), err)
-		}
-	}
-	if err := d.Set("from_port_range", cast.ToInt32(fromRange)); err != nil {
-		return nil, fmt.Errorf("error setting `from_port_range` for Outscale Security Group Rule(%s): %s", d.Id(), err)
-	}
-	if err := d.Set("to_port_range", cast.ToInt32(toRange)); err != nil {
-		return nil, fmt.Errorf("error setting `to_port_range` for Outscale Security Group Rule(%s): %s", d.Id(), err)
-	}
-	if err := d.Set("ip_protocol", ipProtocol); err != nil {
-		return nil, fmt.Errorf("error setting `ip_protocol` for Outscale Security Group Rule(%s): %s", d.Id(), err)
-	}
-	if err := d.Set("ip_range", ipRange); err != nil {
-		return nil, fmt.Errorf("error setting `ip_range` for Outscale Security Group Rule(%s): %s", d.Id(), err)
+	if err := d.Set("flow", flow); err != nil {
+		return nil, fmt.Errorf("error setting `flow` for Outscale Security Group Rule(%s): %s", d.Id(), err)
 	}
 	if err := d.Set("security_group_id", sg.GetSecurityGroupId()); err != nil {
 		return nil, fmt.Errorf("error setting `security_group_id` for Outscale Security Group Rule(%s): %s", d.Id(), err)
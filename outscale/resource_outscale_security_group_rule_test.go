@@ -12,6 +12,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 )
 
@@ -64,6 +65,84 @@ func TestAccOutscaleSecurityGroupRule_withSecurityGroupMember(t *testing.T) {
 	})
 }
 
+func TestAccOutscaleSecurityGroupRule_updateRules(t *testing.T) {
+	resourceName := "outscale_security_group_rule.rule"
+	var group oscgo.SecurityGroup
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOutscaleOAPISecurityGroupRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOutscaleOAPISecurityGroupRuleRulesConfig(rInt, "10.0.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOutscaleOAPIRuleExists(resourceName, &group),
+					testAccCheckOutscaleOAPIRuleAttributes(resourceName, &group, testSecurityGroupRuleRef("tcp", 22, 22, "10.0.0.0/16", "192.168.0.0/24"), "Inbound"),
+				),
+			},
+			{
+				Config: testAccOutscaleOAPISecurityGroupRuleRulesConfig(rInt, "172.16.0.0/12"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOutscaleOAPIRuleExists(resourceName, &group),
+					testAccCheckOutscaleOAPIRuleAttributes(resourceName, &group, testSecurityGroupRuleRef("tcp", 22, 22, "172.16.0.0/12", "192.168.0.0/24"), "Inbound"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.ip_ranges.0", "172.16.0.0/12"),
+				),
+			},
+			{
+				// A single permission of the rule can be imported.
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccCheckOutscaleOAPIRuleRulesImportStateIDFunc(resourceName),
+			},
+		},
+	})
+}
+
+func TestSecurityGroupRulePermissions(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceOutscaleOAPIOutboundRule().Schema, map[string]interface{}{
+		"flow":              "Inbound",
+		"security_group_id": "sg-12345678",
+		"rules": []interface{}{
+			map[string]interface{}{
+				"ip_protocol":     "tcp",
+				"from_port_range": 22,
+				"to_port_range":   22,
+				"ip_ranges":       []interface{}{"10.0.0.0/16", "192.168.0.0/24"},
+			},
+		},
+		"security_group_name_to_link": "sg-87654321",
+	})
+
+	permissions := securityGroupRulePermissions(d.Get)
+	if len(permissions) != 3 {
+		t.Fatalf("expected 3 permissions, got %d: %v", len(permissions), permissions)
+	}
+	if permissions[1].ipRange != "192.168.0.0/24" || permissions[1].fromPortRange != 22 {
+		t.Fatalf("unexpected permission: %#v", permissions[1])
+	}
+	if permissions[2].securityGroupID != "sg-87654321" || permissions[2].ipProtocol != "-1" {
+		t.Fatalf("unexpected linked security group permission: %#v", permissions[2])
+	}
+
+	// The rule created for the linked security group is the permission compared on update.
+	rules := *expandRules(d)
+	members := rules[len(rules)-1].GetSecurityGroupsMembers()
+	if len(members) != 1 || members[0].GetSecurityGroupId() != "sg-87654321" || members[0].HasSecurityGroupName() {
+		t.Fatalf("unexpected linked security group rule: %#v", rules[len(rules)-1])
+	}
+}
+
+func testSecurityGroupRuleRef(protocol string, from, to int32, ipRanges ...string) *oscgo.SecurityGroupRule {
+	rule := oscgo.SecurityGroupRule{}
+	rule.SetIpProtocol(protocol)
+	rule.SetFromPortRange(from)
+	rule.SetToPortRange(to)
+	rule.SetIpRanges(ipRanges)
+	return &rule
+}
+
 func testAccCheckOutscaleOAPISecurityGroupRuleDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*OutscaleClient).OSCAPI
 
@@ -235,6 +314,38 @@ func testAccCheckOutscaleOAPIRuleImportStateIDFunc(resourceName string) resource
 	}
 }
 
+// testAccCheckOutscaleOAPIRuleRulesImportStateIDFunc returns the import ID of the first IP
+// range of the first rule of the resource.
+func testAccCheckOutscaleOAPIRuleRulesImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+		return fmt.Sprintf("%s_%s_%s_%s_%s_%s", rs.Primary.ID, strings.ToLower(rs.Primary.Attributes["flow"]), rs.Primary.Attributes["rules.0.ip_protocol"], rs.Primary.Attributes["rules.0.from_port_range"], rs.Primary.Attributes["rules.0.to_port_range"], rs.Primary.Attributes["rules.0.ip_ranges.0"]), nil
+	}
+}
+
+func testAccOutscaleOAPISecurityGroupRuleRulesConfig(rInt int, ipRange string) string {
+	return fmt.Sprintf(`
+		resource "outscale_security_group" "outscale_security_group" {
+			description         = "test group"
+			security_group_name = "sg-rules-test-group_test_%d"
+		}
+
+		resource "outscale_security_group_rule" "rule" {
+			flow              = "Inbound"
+			security_group_id = outscale_security_group.outscale_security_group.security_group_id
+			rules {
+				from_port_range = 22
+				to_port_range   = 22
+				ip_protocol     = "tcp"
+				ip_ranges       = ["%s", "192.168.0.0/24"]
+			}
+		}
+	`, rInt, ipRange)
+}
+
 func testAccOutscaleOAPISecurityGroupRuleEgressConfig(rInt int) string {
 	return fmt.Sprintf(`
 		resource "outscale_security_group_rule" "outscale_security_group_rule" {
@@ -32,6 +32,105 @@ func TestAccOutscaleOAPISecurityGroup(t *testing.T) {
 	})
 }
 
+func TestAccOutscaleOAPISecurityGroup_exclusiveRules(t *testing.T) {
+	var group oscgo.SecurityGroup
+	resourceName := "outscale_security_group.web"
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOutscaleOAPISGRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOutscaleOAPISecurityGroupExclusiveRulesConfig(rInt, "10.0.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOutscaleOAPISecurityGroupRuleExists(resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "inbound_rules.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "outbound_rules.#", "1"),
+				),
+			},
+			{
+				Config: testAccOutscaleOAPISecurityGroupExclusiveRulesConfig(rInt, "192.168.0.0/24"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOutscaleOAPISecurityGroupRuleExists(resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "inbound_rules.#", "2"),
+				),
+			},
+			{
+				// A rule added outside of Terraform shows as drift and is removed.
+				PreConfig: func() {
+					conn := testAccProvider.Meta().(*OutscaleClient).OSCAPI
+					sg, _, err := readSecurityGroupsWithFilter(conn, &oscgo.FiltersSecurityGroup{
+						SecurityGroupNames: &[]string{fmt.Sprintf("terraform_test_%d", rInt)},
+					})
+					if err != nil {
+						t.Fatal(err)
+					}
+					permission := securityGroupPermission{ipProtocol: "tcp", fromPortRange: 8080, toPortRange: 8080, ipRange: "0.0.0.0/0"}
+					if err := updateSecurityGroupPermissions(conn, sg.GetSecurityGroupId(), "Inbound", []securityGroupPermission{permission}, nil); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:             testAccOutscaleOAPISecurityGroupExclusiveRulesConfig(rInt, "192.168.0.0/24"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccOutscaleOAPISecurityGroupExclusiveRulesConfig(rInt, "192.168.0.0/24"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "inbound_rules.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOutscaleOAPISecurityGroup_exclusiveRulesUnsetFlow(t *testing.T) {
+	var group oscgo.SecurityGroup
+	resourceName := "outscale_security_group.web"
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOutscaleOAPISGRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				// outbound_rules is unset, so the default outbound rule is kept.
+				Config: testAccOutscaleOAPISecurityGroupExclusiveInboundRulesConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOutscaleOAPISecurityGroupRuleExists(resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "inbound_rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "outbound_rules.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOutscaleOAPISecurityGroup_removeDefaultOutboundRule(t *testing.T) {
+	var group oscgo.SecurityGroup
+	resourceName := "outscale_security_group.web"
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOutscaleOAPISGRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOutscaleOAPISecurityGroupRemoveDefaultOutboundRuleConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOutscaleOAPISecurityGroupRuleExists(resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "remove_default_outbound_rule", "true"),
+					resource.TestCheckResourceAttr(resourceName, "outbound_rules.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckOutscaleOAPISGRuleDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*OutscaleClient).OSCAPI
 
@@ -93,3 +192,74 @@ func testAccOutscaleOAPISecurityGroupConfig(rInt int) string {
 		}
 	`, rInt)
 }
+
+func testAccOutscaleOAPISecurityGroupExclusiveRulesConfig(rInt int, ipRange string) string {
+	return fmt.Sprintf(`
+		resource "outscale_net" "net" {
+			ip_range = "10.0.0.0/16"
+		}
+
+		resource "outscale_security_group" "web" {
+			security_group_name = "terraform_test_%d"
+			description         = "Used in the terraform acceptance tests"
+			net_id              = "${outscale_net.net.id}"
+			exclusive_rules     = true
+
+			inbound_rules {
+				ip_protocol     = "tcp"
+				from_port_range = 22
+				to_port_range   = 22
+				ip_ranges       = ["%s"]
+			}
+
+			inbound_rules {
+				ip_protocol     = "tcp"
+				from_port_range = 443
+				to_port_range   = 443
+				ip_ranges       = ["0.0.0.0/0"]
+			}
+
+			outbound_rules {
+				ip_protocol = "-1"
+				ip_ranges   = ["0.0.0.0/0"]
+			}
+		}
+	`, rInt, ipRange)
+}
+
+func testAccOutscaleOAPISecurityGroupRemoveDefaultOutboundRuleConfig(rInt int) string {
+	return fmt.Sprintf(`
+		resource "outscale_net" "net" {
+			ip_range = "10.0.0.0/16"
+		}
+
+		resource "outscale_security_group" "web" {
+			security_group_name          = "terraform_test_%d"
+			description                  = "Used in the terraform acceptance tests"
+			net_id                       = "${outscale_net.net.id}"
+			remove_default_outbound_rule = true
+		}
+	`, rInt)
+}
+
+func testAccOutscaleOAPISecurityGroupExclusiveInboundRulesConfig(rInt int) string {
+	return fmt.Sprintf(`
+		resource "outscale_net" "net" {
+			ip_range = "10.0.0.0/16"
+		}
+
+		resource "outscale_security_group" "web" {
+			security_group_name = "terraform_test_%d"
+			description         = "Used in the terraform acceptance tests"
+			net_id              = "${outscale_net.net.id}"
+			exclusive_rules     = true
+
+			inbound_rules {
+				ip_protocol     = "tcp"
+				from_port_range = 22
+				to_port_range   = 22
+				ip_ranges       = ["10.0.0.0/16"]
+			}
+		}
+	`, rInt)
+}
@@ -53,9 +53,10 @@ func resourceOutscaleOAPIServerCertificate() *schema.Resource {
 				Computed: true,
 			},
 			"private_key": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
 			},
 			"request_id": {
 				Type:     schema.TypeString,
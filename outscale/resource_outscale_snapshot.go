@@ -23,6 +23,7 @@ func resourceOutscaleOAPISnapshot() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffOAPITagsAll,
 
 		Schema: map[string]*schema.Schema{
 			"description": {
@@ -98,7 +99,8 @@ func resourceOutscaleOAPISnapshot() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsListOAPISchema(),
+			"tags":     tagsListOAPISchema(),
+			"tags_all": tagsOAPIListSchemaComputed(),
 			"volume_size": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -180,11 +182,8 @@ func resourceOutscaleOAPISnapshotCreate(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("Error waiting for Snapshot (%s) to be ready: %s", resp.Snapshot.GetSnapshotId(), err)
 	}
 
-	if tags, ok := d.GetOk("tags"); ok {
-		err := assignTags(tags.(*schema.Set), resp.Snapshot.GetSnapshotId(), conn)
-		if err != nil {
-			return err
-		}
+	if err := assignTags(d.Get("tags").(*schema.Set), resp.Snapshot.GetSnapshotId(), meta.(*OutscaleClient)); err != nil {
+		return err
 	}
 
 	d.SetId(resp.Snapshot.GetSnapshotId())
@@ -248,7 +247,7 @@ func resourceOutscaleOAPISnapshotRead(d *schema.ResourceData, meta interface{})
 		if err := set("state", snapshot.GetState()); err != nil {
 			return err
 		}
-		if err := set("tags", tagsOSCAPIToMap(snapshot.GetTags())); err != nil {
+		if err := setOSCAPITagsAttributes(d, meta.(*OutscaleClient), snapshot.GetTags()); err != nil {
 			return err
 		}
 		if err := set("volume_size", snapshot.GetVolumeSize()); err != nil {
@@ -259,11 +258,11 @@ func resourceOutscaleOAPISnapshotRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceOutscaleOAPISnapshotUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*OutscaleClient).OSCAPI
+	client := meta.(*OutscaleClient)
 
 	d.Partial(true)
 
-	if err := setOSCAPITags(conn, d); err != nil {
+	if err := setOSCAPITags(client, d); err != nil {
 		return err
 	}
 
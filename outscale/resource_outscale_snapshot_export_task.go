@@ -23,6 +23,7 @@ func resourceOutscaleOAPISnapshotExportTask() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffOAPITagsAll,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(40 * time.Minute),
@@ -64,9 +65,10 @@ func resourceOutscaleOAPISnapshotExportTask() *schema.Resource {
 										ForceNew: true,
 									},
 									"secret_key": {
-										Type:     schema.TypeString,
-										Required: true,
-										ForceNew: true,
+										Type:      schema.TypeString,
+										Required:  true,
+										ForceNew:  true,
+										Sensitive: true,
 									},
 								},
 							},
@@ -99,7 +101,8 @@ func resourceOutscaleOAPISnapshotExportTask() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsListOAPISchema(),
+			"tags":     tagsListOAPISchema(),
+			"tags_all": tagsOAPIListSchemaComputed(),
 		},
 	}
 }
@@ -172,7 +175,7 @@ func resourceOAPISnapshotExportTaskCreate(d *schema.ResourceData, meta interface
 	id := resp.SnapshotExportTask.GetTaskId()
 	d.SetId(id)
 	if d.IsNewResource() {
-		if err := setOSCAPITags(conn, d); err != nil {
+		if err := setOSCAPITags(meta.(*OutscaleClient), d); err != nil {
 			return err
 		}
 		d.SetPartial("tags")
@@ -259,7 +262,7 @@ func resourceOAPISnapshotExportTaskRead(d *schema.ResourceData, meta interface{}
 	if err = d.Set("osu_export", exp); err != nil {
 		return err
 	}
-	if err = d.Set("tags", tagsOSCAPIToMap(v.GetTags())); err != nil {
+	if err = setOSCAPITagsAttributes(d, meta.(*OutscaleClient), v.GetTags()); err != nil {
 		return err
 	}
 
@@ -267,10 +270,10 @@ func resourceOAPISnapshotExportTaskRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceOAPISnapshotExportTaskUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*OutscaleClient).OSCAPI
+	client := meta.(*OutscaleClient)
 
 	d.Partial(true)
-	if err := setOSCAPITags(conn, d); err != nil {
+	if err := setOSCAPITags(client, d); err != nil {
 		return err
 	}
 	d.SetPartial("tags")
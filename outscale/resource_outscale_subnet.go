@@ -21,6 +21,7 @@ func resourceOutscaleOAPISubNet() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffOAPITagsAll,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
@@ -58,11 +59,8 @@ func resourceOutscaleOAPISubNetCreate(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("[DEBUG] Error creating Subnet (%s)", errString)
 	}
 	result := resp.GetSubnet()
-	if tags, ok := d.GetOk("tags"); ok {
-		err := assignTags(tags.(*schema.Set), result.GetSubnetId(), conn)
-		if err != nil {
-			return err
-		}
+	if err := assignTags(d.Get("tags").(*schema.Set), result.GetSubnetId(), meta.(*OutscaleClient)); err != nil {
+		return err
 	}
 	if result.GetState() != "available" {
 		stateConf := &resource.StateChangeConf{
@@ -110,14 +108,14 @@ func resourceOutscaleOAPISubNetRead(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("[DEBUG] Error reading Subnet (%s)", errString)
 	}
 	if len(resp.GetSubnets()) > 0 {
-		return readOutscaleOAPISubNet(d, &resp.GetSubnets()[0])
+		return readOutscaleOAPISubNet(d, meta, &resp.GetSubnets()[0])
 	}
 	return fmt.Errorf("No subnet (%s) found", d.Id())
 }
 func resourceOutscaleOAPISubNetUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*OutscaleClient).OSCAPI
+	client := meta.(*OutscaleClient)
 	d.Partial(true)
-	if err := setOSCAPITags(conn, d); err != nil {
+	if err := setOSCAPITags(client, d); err != nil {
 		return err
 	}
 	d.SetPartial("tags")
@@ -165,7 +163,7 @@ func resourceOutscaleOAPISubNetDelete(d *schema.ResourceData, meta interface{})
 	d.SetId("")
 	return nil
 }
-func readOutscaleOAPISubNet(d *schema.ResourceData, subnet *oscgo.Subnet) error {
+func readOutscaleOAPISubNet(d *schema.ResourceData, meta interface{}, subnet *oscgo.Subnet) error {
 	if err := d.Set("subregion_name", subnet.GetSubregionName()); err != nil {
 		fmt.Printf("[WARN] ERROR readOutscaleSubNet1 (%s)", err)
 		return err
@@ -194,7 +192,7 @@ func readOutscaleOAPISubNet(d *schema.ResourceData, subnet *oscgo.Subnet) error
 		fmt.Printf("[WARN] ERROR readOutscaleSubNet6 (%s)", err)
 		return err
 	}
-	return d.Set("tags", tagsOSCAPIToMap(subnet.GetTags()))
+	return setOSCAPITagsAttributes(d, meta.(*OutscaleClient), subnet.GetTags())
 }
 
 func SubnetStateOApiRefreshFunc(conn *oscgo.APIClient, subnetID string) resource.StateRefreshFunc {
@@ -254,6 +252,7 @@ func getOAPISubNetSchema() map[string]*schema.Schema {
 			Type:     schema.TypeBool,
 			Computed: true,
 		},
-		"tags": tagsListOAPISchema(),
+		"tags":     tagsListOAPISchema(),
+		"tags_all": tagsOAPIListSchemaComputed(),
 	}
 }
@@ -23,6 +23,7 @@ func resourceOutscaleOAPIVirtualGateway() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffOAPITagsAll,
 
 		Schema: map[string]*schema.Schema{
 			"connection_type": {
@@ -62,7 +63,8 @@ func resourceOutscaleOAPIVirtualGateway() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
-			"tags": tagsListOAPISchema(),
+			"tags":     tagsListOAPISchema(),
+			"tags_all": tagsOAPIListSchemaComputed(),
 		},
 	}
 }
@@ -111,7 +113,7 @@ func resourceOutscaleOAPIVirtualGatewayCreate(d *schema.ResourceData, meta inter
 	d.SetId(virtualGateway.GetVirtualGatewayId())
 
 	if d.IsNewResource() {
-		if err := setOSCAPITags(conn, d); err != nil {
+		if err := setOSCAPITags(meta.(*OutscaleClient), d); err != nil {
 			return err
 		}
 		d.SetPartial("tag")
@@ -178,15 +180,15 @@ func resourceOutscaleOAPIVirtualGatewayRead(d *schema.ResourceData, meta interfa
 	d.Set("virtual_gateway_id", virtualGateway.GetVirtualGatewayId())
 	d.Set("net_to_virtual_gateway_links", vs)
 	d.Set("state", virtualGateway.State)
-	d.Set("tags", tagsOSCAPIToMap(virtualGateway.GetTags()))
+	setOSCAPITagsAttributes(d, meta.(*OutscaleClient), virtualGateway.GetTags())
 
 	return nil
 }
 
 func resourceOutscaleOAPIVirtualGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*OutscaleClient).OSCAPI
+	client := meta.(*OutscaleClient)
 	d.Partial(true)
-	if err := setOSCAPITags(conn, d); err != nil {
+	if err := setOSCAPITags(client, d); err != nil {
 		return err
 	}
 	d.SetPartial("tags")
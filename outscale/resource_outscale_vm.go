@@ -2,6 +2,12 @@ package outscale
 
 import (
 	"context"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -13,6 +19,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
 	"github.com/spf13/cast"
 
@@ -21,7 +28,7 @@ import (
 )
 
 func resourceOutscaleOApiVM() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		Create: resourceOAPIVMCreate,
 		Read:   resourceOAPIVMRead,
 		Update: resourceOAPIVMUpdate,
@@ -29,6 +36,7 @@ func resourceOutscaleOApiVM() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceOAPIVMCustomizeDiff,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -101,7 +109,9 @@ func resourceOutscaleOApiVM() *schema.Resource {
 			},
 			"client_token": {
 				Type:     schema.TypeString,
+				Optional: true,
 				Computed: true,
+				ForceNew: true,
 			},
 			"deletion_protection": {
 				Type:     schema.TypeBool,
@@ -465,9 +475,22 @@ func resourceOutscaleOApiVM() *schema.Resource {
 				Computed: true,
 			},
 			"user_data": {
-				Type:     schema.TypeString,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"user_data_base64"},
+				StateFunc:     userDataHashSum,
+			},
+			"user_data_base64": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"user_data"},
+				ValidateFunc:  validation.StringIsBase64,
+			},
+			"user_data_replace_on_change": {
+				Type:     schema.TypeBool,
 				Optional: true,
-				Computed: true,
+				Default:  false,
 			},
 			"vm_id": {
 				Type:     schema.TypeString,
@@ -489,21 +512,76 @@ func resourceOutscaleOApiVM() *schema.Resource {
 				Computed: true,
 			},
 			"admin_password": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
 			},
 			"get_admin_password": {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
-			"tags": tagsListOAPISchema(),
+			"private_key": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"private_key_path"},
+			},
+			"private_key_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"admin_password_decrypted": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"reboot_triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"replace_triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags":     tagsListOAPISchema(),
+			"tags_all": tagsOAPIListSchemaComputed(),
+		},
+	}
+
+	// Version 0 only lacks user_data_base64 and user_data_replace_on_change, so the current
+	// schema can read it.
+	r.SchemaVersion = 1
+	r.StateUpgraders = []schema.StateUpgrader{
+		{
+			Version: 0,
+			Type:    r.CoreConfigSchema().ImpliedType(),
+			Upgrade: resourceOAPIVMStateUpgradeV0,
 		},
 	}
+	return r
+}
+
+// resourceOAPIVMStateUpgradeV0 moves the user data stored in user_data by previous versions of
+// the provider, which required it to be encoded in Base64, to user_data_base64.
+func resourceOAPIVMStateUpgradeV0(rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	if v, ok := rawState["user_data"].(string); ok && v != "" {
+		rawState["user_data_base64"] = v
+		rawState["user_data"] = ""
+	}
+	return rawState, nil
 }
 
 func resourceOAPIVMCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*OutscaleClient).OSCAPI
 
+	token, err := oapiVMClientToken(d)
+	if err != nil {
+		return err
+	}
+
 	vmOpts, err := buildCreateVmsRequest(d, meta)
 	if err != nil {
 		return err
@@ -519,14 +597,19 @@ func resourceOAPIVMCreate(d *schema.ResourceData, meta interface{}) error {
 		vmOpts.BootOnCreation = oscgo.PtrBool(false)
 	}
 
-	// Create the vm
+	// Create the vm. The request always has a client token, so sending it again returns the
+	// VM created by a request whose response was lost instead of creating another one. The
+	// token is saved as the ID until the VM is launched, so that a VM launched by a failed
+	// apply is found with it by Read, instead of being left behind.
+	d.SetId(token)
 	var resp oscgo.CreateVmsResponse
-	err = resource.Retry(30*time.Second, func() *resource.RetryError {
+	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 		var err error
-		resp, _, err = conn.VmApi.CreateVms(context.Background()).CreateVmsRequest(vmOpts).Execute()
+		var httpResp *http.Response
+		resp, httpResp, err = conn.VmApi.CreateVms(context.Background()).CreateVmsRequest(vmOpts).Execute()
 
 		if err != nil {
-			if strings.Contains(fmt.Sprint(err), "Throttling") {
+			if isOAPIRetryableCreateError(httpResp, err) {
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
@@ -561,11 +644,8 @@ func resourceOAPIVMCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	log.Println("[DEBUG] imprimo log subnet")
-	if tags, ok := d.GetOk("tags"); ok {
-		err := assignTags(tags.(*schema.Set), vm.GetVmId(), conn)
-		if err != nil {
-			return err
-		}
+	if err := assignTags(d.Get("tags").(*schema.Set), vm.GetVmId(), meta.(*OutscaleClient)); err != nil {
+		return err
 	}
 
 	stateConf := &resource.StateChangeConf{
@@ -580,7 +660,7 @@ func resourceOAPIVMCreate(d *schema.ResourceData, meta interface{}) error {
 	_, err = stateConf.WaitForState()
 	if err != nil {
 		return fmt.Errorf(
-			"Error waiting for instance (%s) to become created: %s", d.Id(), err)
+			"Error waiting for instance (%s) to become created: %s%s", d.Id(), err, vmConsoleOutputTail(conn, d.Id()))
 	}
 
 	// Initialize the connection info
@@ -616,6 +696,10 @@ func resourceOAPIVMCreate(d *schema.ResourceData, meta interface{}) error {
 func resourceOAPIVMRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*OutscaleClient).OSCAPI
 
+	if err := resolveOAPIVMClientTokenID(d, conn); err != nil || d.Id() == "" {
+		return err
+	}
+
 	var resp oscgo.ReadVmsResponse
 	err := resource.Retry(30*time.Second, func() *resource.RetryError {
 		r, _, err := conn.VmApi.ReadVms(context.Background()).ReadVmsRequest(oscgo.ReadVmsRequest{
@@ -647,21 +731,110 @@ func resourceOAPIVMRead(d *schema.ResourceData, meta interface{}) error {
 
 	vm := resp.GetVms()[0]
 
-	// Get the admin password from the server to save in the state
-	adminPassword, err := getOAPIVMAdminPassword(vm.GetVmId(), conn)
-	if err != nil {
+	if err := setOAPIVMAdminPassword(d, conn); err != nil {
 		return err
 	}
 
 	return resourceDataAttrSetter(d, func(set AttributeSetter) error {
-		if err := d.Set("admin_password", adminPassword); err != nil {
+		d.SetId(vm.GetVmId())
+		if err := oapiVMDescriptionAttributes(set, &vm); err != nil {
 			return err
 		}
-		d.SetId(vm.GetVmId())
-		return oapiVMDescriptionAttributes(set, &vm)
+		if err := setOAPIVMUserData(d, vm.GetUserData()); err != nil {
+			return err
+		}
+		return setOSCAPITagsAttributes(d, meta.(*OutscaleClient), vm.GetTags())
 	})
 }
 
+// setOAPIVMAdminPassword sets the admin password of the VM when get_admin_password is true,
+// and decrypts it when a private key is given. The password is kept in the state once
+// retrieved: the API doesn't return it anymore after the first reboot of the VM.
+func setOAPIVMAdminPassword(d *schema.ResourceData, conn *oscgo.APIClient) error {
+	if !d.Get("get_admin_password").(bool) {
+		return nil
+	}
+
+	adminPassword := d.Get("admin_password").(string)
+	if adminPassword == "" {
+		var err error
+		if adminPassword, err = getOAPIVMAdminPassword(d.Id(), conn); err != nil {
+			return err
+		}
+		if err := d.Set("admin_password", adminPassword); err != nil {
+			return err
+		}
+	}
+
+	privateKey, err := getOAPIVMPrivateKey(d)
+	if err != nil {
+		return err
+	}
+	if adminPassword == "" || privateKey == "" {
+		return d.Set("admin_password_decrypted", "")
+	}
+
+	decrypted, err := decryptOAPIVMAdminPassword(adminPassword, privateKey)
+	if err != nil {
+		return fmt.Errorf("error decrypting the admin password of the VM (%s): %s", d.Id(), err)
+	}
+	return d.Set("admin_password_decrypted", decrypted)
+}
+
+func getOAPIVMPrivateKey(d *schema.ResourceData) (string, error) {
+	if path, ok := d.GetOk("private_key_path"); ok {
+		privateKey, err := ioutil.ReadFile(path.(string))
+		if err != nil {
+			return "", fmt.Errorf("error reading the private key file %s: %s", path, err)
+		}
+		return string(privateKey), nil
+	}
+	return d.Get("private_key").(string), nil
+}
+
+// decryptOAPIVMAdminPassword decrypts the admin password of a Windows VM, encrypted with the
+// public key of its keypair and encoded in Base64.
+func decryptOAPIVMAdminPassword(adminPassword, privateKey string) (string, error) {
+	block, _ := pem.Decode([]byte(privateKey))
+	if block == nil {
+		return "", errors.New("the private key is not in PEM format")
+	}
+	if x509.IsEncryptedPEMBlock(block) {
+		return "", errors.New("the private key is protected by a passphrase, which is not supported")
+	}
+
+	var key *rsa.PrivateKey
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		var err error
+		if key, err = x509.ParsePKCS1PrivateKey(block.Bytes); err != nil {
+			return "", err
+		}
+	case "PRIVATE KEY":
+		parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return "", err
+		}
+		var ok bool
+		if key, ok = parsed.(*rsa.PrivateKey); !ok {
+			return "", errors.New("the private key is not an RSA key")
+		}
+	default:
+		return "", fmt.Errorf("unsupported private key type %q", block.Type)
+	}
+
+	encrypted, err := base64.StdEncoding.DecodeString(strings.TrimSpace(adminPassword))
+	if err != nil {
+		return "", fmt.Errorf("the admin password is not encoded in Base64: %s", err)
+	}
+
+	decrypted, err := rsa.DecryptPKCS1v15(nil, key, encrypted)
+	if err != nil {
+		return "", err
+	}
+	return string(decrypted), nil
+}
+
 func getOAPIVMAdminPassword(VMID string, conn *oscgo.APIClient) (string, error) {
 	resp, _, err := conn.VmApi.ReadAdminPassword(context.Background()).ReadAdminPasswordRequest(oscgo.ReadAdminPasswordRequest{VmId: VMID}).Execute()
 
@@ -683,29 +856,39 @@ func resourceOAPIVMUpdate(d *schema.ResourceData, meta interface{}) error {
 	os := o.(map[string]interface{})
 	ns := n.(map[string]interface{})
 
+	// These arguments are only used by the provider, reading the VM is enough to apply them.
+	localOnly := map[string]bool{
+		"get_admin_password": true,
+		"private_key":        true,
+		"private_key_path":   true,
+	}
+
 	for k := range os {
-		if d.HasChange(k) && k != "get_admin_password" {
+		if d.HasChange(k) && !localOnly[k] {
 			nothingToDo = false
 		}
 	}
 
 	for k := range ns {
-		if d.HasChange(k) && k != "get_admin_password" {
+		if d.HasChange(k) && !localOnly[k] {
 			nothingToDo = false
 		}
 	}
 
 	if nothingToDo == true {
-		return nil
+		return resourceOAPIVMRead(d, meta)
 	}
 
+	// A VM stopped to be updated is started again below, which makes a reboot useless.
+	stopped := false
 	if d.HasChange("vm_type") && !d.IsNewResource() ||
-		d.HasChange("user_data") && !d.IsNewResource() ||
+		oapiVMUserDataChanged(d) && !d.IsNewResource() ||
 		d.HasChange("bsu_optimized") && !d.IsNewResource() ||
 		d.HasChange("performance") && !d.IsNewResource() {
 		if err := stopVM(id, conn); err != nil {
 			return err
 		}
+		stopped = true
 	}
 
 	if d.HasChange("vm_type") && !d.IsNewResource() {
@@ -717,9 +900,9 @@ func resourceOAPIVMUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if d.HasChange("user_data") && !d.IsNewResource() {
+	if oapiVMUserDataChanged(d) && !d.IsNewResource() {
 		opts := oscgo.UpdateVmRequest{VmId: id}
-		opts.SetUserData(d.Get("user_data").(string))
+		opts.SetUserData(expandOAPIVMUserData(d))
 
 		if err := updateVmAttr(conn, opts); err != nil {
 			return err
@@ -841,7 +1024,7 @@ func resourceOAPIVMUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if err := setOSCAPITags(conn, d); err != nil {
+	if err := setOSCAPITags(meta.(*OutscaleClient), d); err != nil {
 		return err
 	}
 
@@ -869,12 +1052,22 @@ func resourceOAPIVMUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("reboot_triggers") && !d.IsNewResource() && !stopped && d.Get("state").(string) != "stopped" {
+		if err := rebootVM(id, conn, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
 	return resourceOAPIVMRead(d, meta)
 }
 
 func resourceOAPIVMDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*OutscaleClient).OSCAPI
 
+	if err := resolveOAPIVMClientTokenID(d, conn); err != nil || d.Id() == "" {
+		return err
+	}
+
 	id := d.Id()
 
 	log.Printf("[INFO] Terminating VM: %s", id)
@@ -919,6 +1112,116 @@ func resourceOAPIVMDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func resourceOAPIVMCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if err := customizeDiffOAPIVMUserData(diff); err != nil {
+		return err
+	}
+	return customizeDiffOAPITagsAll(diff, meta)
+}
+
+// customizeDiffOAPIVMUserData replaces the VM when its user data changes and
+// user_data_replace_on_change is true. Otherwise the VM is stopped and started by Update, and
+// the plan only shows an in-place update of the user data.
+func customizeDiffOAPIVMUserData(diff *schema.ResourceDiff) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	// The state of the VMs created by previous versions of the provider, which required
+	// user_data to be encoded in Base64, was upgraded to user_data_base64.
+	oldBase64, newBase64 := diff.GetChange("user_data_base64")
+	if _, userData := diff.GetChange("user_data"); oldBase64.(string) != "" && newBase64.(string) == "" && userData.(string) == oldBase64.(string) {
+		return fmt.Errorf("user_data now takes the user data in clear text: " +
+			"move the Base64-encoded user data to user_data_base64, or decode it")
+	}
+
+	if !oapiVMUserDataChanged(diff) || !diff.Get("user_data_replace_on_change").(bool) {
+		return nil
+	}
+	for _, k := range []string{"user_data", "user_data_base64"} {
+		if diff.HasChange(k) {
+			if err := diff.ForceNew(k); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// oapiVMClientToken returns the client token of the resource, generated and set when it is
+// not given. It is generated at apply time, as a value generated at plan time would change
+// when the plan is computed again during the apply.
+func oapiVMClientToken(d *schema.ResourceData) (string, error) {
+	if token := d.Get("client_token").(string); token != "" {
+		return token, nil
+	}
+	token := resource.PrefixedUniqueId("terraform-")
+	return token, d.Set("client_token", token)
+}
+
+// readOAPIVMsByClientToken returns the VMs launched with the client token, which are not
+// terminated. The API can't filter the VMs by client token, so all of them are read.
+func readOAPIVMsByClientToken(conn *oscgo.APIClient, token string) ([]oscgo.Vm, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	var resp oscgo.ReadVmsResponse
+	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		var err error
+		resp, _, err = conn.VmApi.ReadVms(context.Background()).ReadVmsRequest(oscgo.ReadVmsRequest{}).Execute()
+		if err != nil {
+			if strings.Contains(err.Error(), "RequestLimitExceeded:") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading the VMs launched with the client token %s: %s", token, utils.GetErrorResponse(err))
+	}
+
+	var vms []oscgo.Vm
+	for _, vm := range resp.GetVms() {
+		if vm.GetClientToken() == token && vm.GetState() != "shutting-down" && vm.GetState() != "terminated" {
+			vms = append(vms, vm)
+		}
+	}
+	return vms, nil
+}
+
+// resolveOAPIVMClientTokenID replaces the client token saved as the ID of the VM, when its
+// launch failed without telling if the VM was created, by the ID of the VM launched with the
+// token. The ID is unset when there is no such VM.
+func resolveOAPIVMClientTokenID(d *schema.ResourceData, conn *oscgo.APIClient) error {
+	if d.Id() == "" || d.Id() != d.Get("client_token").(string) {
+		return nil
+	}
+	vms, err := readOAPIVMsByClientToken(conn, d.Id())
+	if err != nil {
+		return err
+	}
+	if len(vms) == 0 {
+		log.Printf("[WARN] No VM launched with the client token %s, removing it from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	log.Printf("[INFO] Found the VM (%s) launched with the client token %s", vms[0].GetVmId(), d.Id())
+	d.SetId(vms[0].GetVmId())
+	return nil
+}
+
+// isOAPIRetryableCreateError tells if a create request with a client token can be sent again:
+// when the API is throttling, or when no response was received and the request may have
+// succeeded.
+func isOAPIRetryableCreateError(httpResp *http.Response, err error) bool {
+	if strings.Contains(fmt.Sprint(err), "RequestLimitExceeded") || strings.Contains(fmt.Sprint(err), "Throttling") {
+		return true
+	}
+	return httpResp == nil
+}
+
 func buildCreateVmsRequest(d *schema.ResourceData, meta interface{}) (oscgo.CreateVmsRequest, error) {
 	request := oscgo.CreateVmsRequest{
 		DeletionProtection: oscgo.PtrBool(d.Get("deletion_protection").(bool)),
@@ -954,7 +1257,7 @@ func buildCreateVmsRequest(d *schema.ResourceData, meta interface{}) (oscgo.Crea
 		request.SetSubnetId(v)
 	}
 
-	if v := d.Get("user_data").(string); v != "" {
+	if v := expandOAPIVMUserData(d); v != "" {
 		request.SetUserData(v)
 	}
 
@@ -1107,6 +1410,98 @@ func expandPlacement(d *schema.ResourceData) *oscgo.Placement {
 	return placement
 }
 
+// expandOAPIVMUserData returns the user data of the VM encoded in Base64 for the API.
+func expandOAPIVMUserData(d *schema.ResourceData) string {
+	if v := d.Get("user_data").(string); v != "" {
+		return base64.StdEncoding.EncodeToString([]byte(v))
+	}
+	return d.Get("user_data_base64").(string)
+}
+
+// oapiVMUserDataDiff is implemented by schema.ResourceData and schema.ResourceDiff.
+type oapiVMUserDataDiff interface {
+	GetChange(string) (interface{}, interface{})
+	HasChange(string) bool
+}
+
+// oapiVMUserDataChanged tells if the user data of the VM changes. The same user data moved
+// between user_data and user_data_base64 doesn't change.
+func oapiVMUserDataChanged(d oapiVMUserDataDiff) bool {
+	if !d.HasChange("user_data") && !d.HasChange("user_data_base64") {
+		return false
+	}
+
+	// The state holds the hash of user_data, the new value of user_data is in clear text.
+	oldUserData, newUserData := d.GetChange("user_data")
+	oldBase64, newBase64 := d.GetChange("user_data_base64")
+
+	oldHash := oldUserData.(string)
+	if v := oldBase64.(string); v != "" {
+		oldHash = userDataBase64HashSum(v)
+	}
+	newHash := oldUserData.(string)
+	if d.HasChange("user_data") {
+		newHash = userDataHashSum(newUserData)
+	}
+	if v := newBase64.(string); v != "" {
+		newHash = userDataBase64HashSum(v)
+	}
+	return oldHash != newHash
+}
+
+// setOAPIVMUserData sets the user data returned by the API in the argument used to configure
+// it: as is in user_data_base64, or decoded and hashed in user_data.
+func setOAPIVMUserData(d *schema.ResourceData, userData string) error {
+	if _, ok := d.GetOk("user_data_base64"); ok {
+		if err := d.Set("user_data", ""); err != nil {
+			return err
+		}
+		return d.Set("user_data_base64", userData)
+	}
+
+	return d.Set("user_data", userDataBase64HashSum(userData))
+}
+
+// userDataBase64HashSum returns the hash of the user data encoded in Base64, as stored in
+// user_data.
+func userDataBase64HashSum(v string) string {
+	decoded, err := base64.StdEncoding.DecodeString(v)
+	if err != nil {
+		// The API and user_data_base64 only hold Base64 values.
+		return userDataHashSum(v)
+	}
+	return userDataHashSum(string(decoded))
+}
+
+// userDataHashSum stores a hash of the user data in the state instead of the user data itself,
+// which can be long or hold secrets.
+func userDataHashSum(v interface{}) string {
+	s, ok := v.(string)
+	if !ok || s == "" {
+		return ""
+	}
+	hash := sha1.Sum([]byte(s))
+	return hex.EncodeToString(hash[:])
+}
+
+// vmConsoleOutputErrorLines is the number of lines of console output attached to the error
+// when a VM fails to start.
+const vmConsoleOutputErrorLines = 30
+
+// vmConsoleOutputTail returns the end of the console output of the VM vmID to help debugging
+// a failed boot, or nothing if it can't be read.
+func vmConsoleOutputTail(conn *oscgo.APIClient, vmID string) string {
+	consoleOutput, _, err := readOAPIVMConsoleOutput(conn, vmID)
+	if err != nil {
+		log.Printf("[WARN] error reading the console output of the VM (%s): %s", vmID, err)
+		return ""
+	}
+	if strings.TrimSpace(consoleOutput) == "" {
+		return ""
+	}
+	return fmt.Sprintf("\n\nLast %d lines of the console output:\n%s", vmConsoleOutputErrorLines, lastLines(consoleOutput, vmConsoleOutputErrorLines))
+}
+
 func vmStateRefreshFunc(conn *oscgo.APIClient, instanceID, failState string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		resp, _, err := conn.VmApi.ReadVms(context.Background()).ReadVmsRequest(oscgo.ReadVmsRequest{
@@ -1137,6 +1532,10 @@ func vmStateRefreshFunc(conn *oscgo.APIClient, instanceID, failState string) res
 }
 
 func stopVM(vmID string, conn *oscgo.APIClient) error {
+	return stopVMWithTimeout(vmID, conn, 10*time.Minute)
+}
+
+func stopVMWithTimeout(vmID string, conn *oscgo.APIClient, timeout time.Duration) error {
 	vmResp, _, err := readVM(vmID, conn)
 	if err != nil {
 		return err
@@ -1165,7 +1564,7 @@ func stopVM(vmID string, conn *oscgo.APIClient) error {
 		Pending:    []string{"pending", "running", "shutting-down", "stopped", "stopping"},
 		Target:     []string{"stopped"},
 		Refresh:    vmStateRefreshFunc(conn, vmID, ""),
-		Timeout:    10 * time.Minute,
+		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 3 * time.Second,
 	}
@@ -1187,6 +1586,10 @@ func stopVM(vmID string, conn *oscgo.APIClient) error {
 }
 
 func startVM(vmID string, conn *oscgo.APIClient) error {
+	return startVMWithTimeout(vmID, conn, 10*time.Minute)
+}
+
+func startVMWithTimeout(vmID string, conn *oscgo.APIClient, timeout time.Duration) error {
 	_, _, err := conn.VmApi.StartVms(context.Background()).StartVmsRequest(oscgo.StartVmsRequest{
 		VmIds: []string{vmID},
 	}).Execute()
@@ -1199,7 +1602,7 @@ func startVM(vmID string, conn *oscgo.APIClient) error {
 		Pending:    []string{"pending", "stopped"},
 		Target:     []string{"running"},
 		Refresh:    vmStateRefreshFunc(conn, vmID, ""),
-		Timeout:    10 * time.Minute,
+		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 3 * time.Second,
 	}
@@ -1211,6 +1614,19 @@ func startVM(vmID string, conn *oscgo.APIClient) error {
 	return nil
 }
 
+// rebootVM stops and starts the VM within timeout. RebootVms is not used, as the state of
+// the VM doesn't change during a reboot and there would be no way to tell when it is done.
+func rebootVM(vmID string, conn *oscgo.APIClient, timeout time.Duration) error {
+	start := time.Now()
+	if err := stopVMWithTimeout(vmID, conn, timeout); err != nil {
+		return fmt.Errorf("error rebooting vm %s: %s", vmID, err)
+	}
+	if err := startVMWithTimeout(vmID, conn, timeout-time.Since(start)); err != nil {
+		return fmt.Errorf("error rebooting vm %s: %s", vmID, err)
+	}
+	return nil
+}
+
 func updateVmAttr(conn *oscgo.APIClient, instanceAttrOpts oscgo.UpdateVmRequest) error {
 	if _, httpResp, err := conn.VmApi.UpdateVm(context.Background()).UpdateVmRequest(instanceAttrOpts).Execute(); err != nil {
 		bodyBytes, errBody := ioutil.ReadAll(httpResp.Body)
@@ -2,8 +2,17 @@ package outscale
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"regexp"
 	"strings"
 	"testing"
 	"time"
@@ -36,6 +45,8 @@ func TestAccOutscaleOAPIVM_Basic(t *testing.T) {
 						"outscale_vm.basic", "image_id", omi),
 					resource.TestCheckResourceAttr(
 						"outscale_vm.basic", "vm_type", "tinav4.c2r2p2"),
+					resource.TestMatchResourceAttr(
+						"outscale_vm.basic", "client_token", regexp.MustCompile("^terraform-")),
 				),
 			},
 		},
@@ -317,6 +328,85 @@ func TestAccOutscaleOAPIVM_DeletionProtectionUpdate(t *testing.T) {
 	})
 }
 
+func TestAccOutscaleOAPIVM_Triggers(t *testing.T) {
+	var first, rebooted, replaced oscgo.Vm
+	omi := os.Getenv("OUTSCALE_IMAGEID")
+	keypair := os.Getenv("OUTSCALE_KEYPAIR")
+	resourceName := "outscale_vm.outscale_vm"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOutscaleOAPIVMDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckOutscaleOAPIVMConfigTriggers(omi, keypair, "v1", "v1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOutscaleOAPIVMExists(resourceName, &first),
+					resource.TestCheckResourceAttr(resourceName, "reboot_triggers.config", "v1"),
+					resource.TestCheckResourceAttr(resourceName, "replace_triggers.image", "v1"),
+				),
+			},
+			{
+				Config: testAccCheckOutscaleOAPIVMConfigTriggers(omi, keypair, "v2", "v1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOutscaleOAPIVMExists(resourceName, &rebooted),
+					resource.TestCheckResourceAttr(resourceName, "reboot_triggers.config", "v2"),
+					resource.TestCheckResourceAttr(resourceName, "state", "running"),
+					func(s *terraform.State) error {
+						assertEqual(t, first.GetVmId(), rebooted.GetVmId(), "Outscale VM was recreated by a reboot trigger.")
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccCheckOutscaleOAPIVMConfigTriggers(omi, keypair, "v2", "v2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOutscaleOAPIVMExists(resourceName, &replaced),
+					resource.TestCheckResourceAttr(resourceName, "replace_triggers.image", "v2"),
+					func(s *terraform.State) error {
+						assertNotEqual(t, rebooted.GetVmId(), replaced.GetVmId(), "Outscale VM was not replaced by a replace trigger.")
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccOutscaleOAPIVM_UserDataReplaceOnChange(t *testing.T) {
+	var before, after oscgo.Vm
+	omi := os.Getenv("OUTSCALE_IMAGEID")
+	keypair := os.Getenv("OUTSCALE_KEYPAIR")
+	resourceName := "outscale_vm.outscale_vm"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOutscaleOAPIVMDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckOutscaleOAPIVMConfigUserData(omi, keypair, "hello"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOutscaleOAPIVMExists(resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, "user_data", userDataHashSum("#!/bin/bash\necho hello\n")),
+				),
+			},
+			{
+				Config: testAccCheckOutscaleOAPIVMConfigUserData(omi, keypair, "world"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOutscaleOAPIVMExists(resourceName, &after),
+					resource.TestCheckResourceAttr(resourceName, "user_data", userDataHashSum("#!/bin/bash\necho world\n")),
+					func(s *terraform.State) error {
+						assertNotEqual(t, before.GetVmId(), after.GetVmId(), "Outscale VM was not replaced when its user data changed.")
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func TestAccOutscaleOAPIVMTags_Update(t *testing.T) {
 	omi := os.Getenv("OUTSCALE_IMAGEID")
 	region := os.Getenv("OUTSCALE_REGION")
@@ -446,6 +536,39 @@ func testAccCheckOutscaleDeletionProtectionUpdateBasic(omi, deletionProtection,
 	`, omi, deletionProtection, keypair)
 }
 
+func testAccCheckOutscaleOAPIVMConfigTriggers(omi, keypair, reboot, replace string) string {
+	return fmt.Sprintf(`
+		resource "outscale_vm" "outscale_vm" {
+			image_id     = "%[1]s"
+			vm_type      = "tinav4.c2r2p2"
+			keypair_name = "%[2]s"
+
+			reboot_triggers = {
+				config = "%[3]s"
+			}
+
+			replace_triggers = {
+				image = "%[4]s"
+			}
+		}
+	`, omi, keypair, reboot, replace)
+}
+
+func testAccCheckOutscaleOAPIVMConfigUserData(omi, keypair, message string) string {
+	return fmt.Sprintf(`
+		resource "outscale_vm" "outscale_vm" {
+			image_id                    = "%[1]s"
+			vm_type                     = "tinav4.c2r2p2"
+			keypair_name                = "%[2]s"
+			user_data_replace_on_change = true
+			user_data                   = <<EOF
+#!/bin/bash
+echo %[3]s
+EOF
+		}
+	`, omi, keypair, message)
+}
+
 //TODO: check if is needed
 // func testAccCheckOAPIVMSecurityGroupsUpdated(t *testing.T, before, after *oscgo.Vm) resource.TestCheckFunc {
 // 	return func(s *terraform.State) error {
@@ -1051,3 +1174,214 @@ func testAccCheckOutscaleOAPIVMBehaviorConfigBasic(omi, vmType, region, keypair,
 			}
 		}`, omi, vmType, region, keypair, perfomance, vmBehavior)
 }
+
+func TestDecryptOAPIVMAdminPassword(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	encrypted, err := rsa.EncryptPKCS1v15(rand.Reader, &key.PublicKey, []byte("Pa$$w0rd"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	adminPassword := base64.StdEncoding.EncodeToString(encrypted) + "\n"
+
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	privateKeys := map[string]string{
+		"pkcs1": string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})),
+		"pkcs8": string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8})),
+	}
+	for name, privateKey := range privateKeys {
+		decrypted, err := decryptOAPIVMAdminPassword(adminPassword, privateKey)
+		if err != nil {
+			t.Fatalf("%s: %s", name, err)
+		}
+		if decrypted != "Pa$$w0rd" {
+			t.Fatalf("%s: expected Pa$$w0rd, got %q", name, decrypted)
+		}
+	}
+
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherPrivateKey := string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(otherKey)}))
+	if _, err := decryptOAPIVMAdminPassword(adminPassword, otherPrivateKey); err == nil {
+		t.Fatal("expected an error decrypting with another key")
+	}
+	if _, err := decryptOAPIVMAdminPassword(adminPassword, "not a key"); err == nil {
+		t.Fatal("expected an error with a key not in PEM format")
+	}
+}
+
+func TestIsOAPIRetryableCreateError(t *testing.T) {
+	cases := []struct {
+		httpResp *http.Response
+		err      error
+		expected bool
+	}{
+		{nil, errors.New("dial tcp: i/o timeout"), true},
+		{&http.Response{StatusCode: 503}, errors.New("503 Service Unavailable RequestLimitExceeded"), true},
+		{&http.Response{StatusCode: 400}, errors.New("400 Bad Request"), false},
+	}
+	for _, c := range cases {
+		if got := isOAPIRetryableCreateError(c.httpResp, c.err); got != c.expected {
+			t.Errorf("isOAPIRetryableCreateError(%v): expected %t, got %t", c.err, c.expected, got)
+		}
+	}
+}
+
+func TestResolveOAPIVMClientTokenID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"Vms":[
+			{"VmId":"i-00000001","ClientToken":"other","State":"running"},
+			{"VmId":"i-00000002","ClientToken":"terraform-token","State":"terminated"},
+			{"VmId":"i-00000003","ClientToken":"terraform-token","State":"running"}
+		]}`))
+	}))
+	defer server.Close()
+
+	config := oscgo.NewConfiguration()
+	config.Scheme = "http"
+	config.Host = strings.TrimPrefix(server.URL, "http://")
+	conn := oscgo.NewAPIClient(config)
+
+	cases := []struct {
+		id, token, expected string
+	}{
+		// The launch failed, the VM launched with the token is found.
+		{"terraform-token", "terraform-token", "i-00000003"},
+		// The launch failed, no VM was launched with the token.
+		{"terraform-missing", "terraform-missing", ""},
+		// The VM was launched.
+		{"i-00000004", "terraform-token", "i-00000004"},
+	}
+	for _, c := range cases {
+		d := schema.TestResourceDataRaw(t, resourceOutscaleOApiVM().Schema, map[string]interface{}{
+			"client_token": c.token,
+		})
+		d.SetId(c.id)
+		if err := resolveOAPIVMClientTokenID(d, conn); err != nil {
+			t.Fatalf("%s: %s", c.id, err)
+		}
+		if d.Id() != c.expected {
+			t.Errorf("%s: expected ID %q, got %q", c.id, c.expected, d.Id())
+		}
+	}
+}
+
+func TestOAPIVMUserData(t *testing.T) {
+	vmSchema := resourceOutscaleOApiVM().Schema
+	encoded := base64.StdEncoding.EncodeToString([]byte("#!/bin/bash"))
+
+	d := schema.TestResourceDataRaw(t, vmSchema, map[string]interface{}{"user_data": "#!/bin/bash"})
+	if got := expandOAPIVMUserData(d); got != encoded {
+		t.Fatalf("expected user_data to be encoded to %q, got %q", encoded, got)
+	}
+	if err := setOAPIVMUserData(d, encoded); err != nil {
+		t.Fatal(err)
+	}
+	if got := d.Get("user_data").(string); got != userDataHashSum("#!/bin/bash") {
+		t.Fatalf("expected user_data to be hashed, got %q", got)
+	}
+
+	d = schema.TestResourceDataRaw(t, vmSchema, map[string]interface{}{"user_data_base64": encoded})
+	if got := expandOAPIVMUserData(d); got != encoded {
+		t.Fatalf("expected user_data_base64 to be sent as is, got %q", got)
+	}
+	if err := setOAPIVMUserData(d, encoded); err != nil {
+		t.Fatal(err)
+	}
+	if got := d.Get("user_data_base64").(string); got != encoded {
+		t.Fatalf("expected user_data_base64 to be %q, got %q", encoded, got)
+	}
+	if got := d.Get("user_data").(string); got != "" {
+		t.Fatalf("expected user_data to be empty, got %q", got)
+	}
+
+	// A clear text value which is valid Base64 is still clear text.
+	d = schema.TestResourceDataRaw(t, vmSchema, map[string]interface{}{"user_data": "test"})
+	if got := expandOAPIVMUserData(d); got != base64.StdEncoding.EncodeToString([]byte("test")) {
+		t.Fatalf("expected user_data to be encoded, got %q", got)
+	}
+	if got := userDataHashSum("test"); got == userDataBase64HashSum("test") {
+		t.Fatalf("expected user_data not to be decoded")
+	}
+}
+
+func TestResourceOAPIVMStateUpgradeV0(t *testing.T) {
+	encoded := base64.StdEncoding.EncodeToString([]byte("#!/bin/bash"))
+
+	rawState, err := resourceOAPIVMStateUpgradeV0(map[string]interface{}{
+		"image_id":  "ami-12345678",
+		"user_data": encoded,
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rawState["user_data"] != "" || rawState["user_data_base64"] != encoded {
+		t.Fatalf("expected the user data to be moved to user_data_base64, got %#v", rawState)
+	}
+
+	r := resourceOutscaleOApiVM()
+	state := &terraform.InstanceState{
+		ID: "i-12345678",
+		Attributes: map[string]string{
+			"image_id":         rawState["image_id"].(string),
+			"user_data":        rawState["user_data"].(string),
+			"user_data_base64": rawState["user_data_base64"].(string),
+		},
+	}
+	diff := func(config map[string]interface{}) (*terraform.InstanceDiff, error) {
+		config["image_id"] = "ami-12345678"
+		return r.Diff(state, terraform.NewResourceConfigRaw(config), &OutscaleClient{})
+	}
+
+	// The configuration moved to user_data_base64 doesn't change.
+	d, err := diff(map[string]interface{}{"user_data_base64": encoded})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a := d.Attributes["user_data_base64"]; a != nil && a.Old != a.New {
+		t.Fatalf("expected no change of user_data_base64, got %#v", a)
+	}
+
+	// The configuration of previous versions is rejected instead of encoding the user data twice.
+	if _, err := diff(map[string]interface{}{"user_data": encoded}); err == nil || !strings.Contains(err.Error(), "user_data_base64") {
+		t.Fatalf("expected the Base64-encoded user_data to be rejected, got %v", err)
+	}
+
+	// The same user data in clear text is not updated, even if the plan shows a change.
+	d, err = diff(map[string]interface{}{"user_data": "#!/bin/bash", "user_data_replace_on_change": true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, k := range []string{"user_data", "user_data_base64"} {
+		if a := d.Attributes[k]; a != nil && a.RequiresNew {
+			t.Fatalf("expected the VM not to be replaced for the same user data, got %s %#v", k, a)
+		}
+	}
+	data, err := schema.InternalMap(r.Schema).Data(state, d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if oapiVMUserDataChanged(data) {
+		t.Fatalf("expected the user data not to change")
+	}
+
+	// Other user data is updated.
+	d, err = diff(map[string]interface{}{"user_data": "#!/bin/sh"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data, err = schema.InternalMap(r.Schema).Data(state, d); err != nil {
+		t.Fatal(err)
+	}
+	if !oapiVMUserDataChanged(data) {
+		t.Fatalf("expected the user data to change")
+	}
+}
@@ -0,0 +1,554 @@
+package outscale
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	oscgo "github.com/outscale/osc-sdk-go/v2"
+	"github.com/terraform-providers/terraform-provider-outscale/utils"
+)
+
+func resourceOutscaleOAPIVMs() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceOAPIVMsCreate,
+		Read:          resourceOAPIVMsRead,
+		Update:        resourceOAPIVMsUpdate,
+		Delete:        resourceOAPIVMsDelete,
+		CustomizeDiff: resourceOAPIVMsCustomizeDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"max_count": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"min_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"client_token": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"image_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vm_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"keypair_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"security_group_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"security_group_names": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"placement_subregion_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"placement_tenancy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"bsu_optimized": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"performance": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"medium", "high", "highest"}, false),
+			},
+			"user_data": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"user_data_base64"},
+				StateFunc:     userDataHashSum,
+			},
+			"user_data_base64": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"user_data"},
+				ValidateFunc:  validation.StringIsBase64,
+			},
+			"vm_initiated_shutdown_behavior": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"vm_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"vms": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"vm_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"placement_subregion_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"private_dns_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"private_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"public_dns_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"public_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"request_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tagsListOAPISchema(),
+			"tags_all": tagsOAPIListSchemaComputed(),
+		},
+	}
+}
+
+// resourceOAPIVMsCustomizeDiff plans the launch of the missing VMs when fewer than max_count
+// VMs are running, because the API launched only min_count of them or because some of them
+// were terminated outside of Terraform.
+func resourceOAPIVMsCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	maxCount := diff.Get("max_count").(int)
+	if minCount := diff.Get("min_count").(int); minCount > maxCount {
+		return fmt.Errorf("min_count (%d) can't be greater than max_count (%d)", minCount, maxCount)
+	}
+
+	if diff.Id() != "" && len(diff.Get("vm_ids").([]interface{})) != maxCount {
+		if err := diff.SetNewComputed("vm_ids"); err != nil {
+			return err
+		}
+		if err := diff.SetNewComputed("vms"); err != nil {
+			return err
+		}
+	}
+
+	return customizeDiffOAPITagsAll(diff, meta)
+}
+
+func resourceOAPIVMsCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*OutscaleClient).OSCAPI
+
+	if _, err := oapiVMClientToken(d); err != nil {
+		return err
+	}
+
+	// The resource is saved before the VMs are launched. If the launch fails without telling
+	// if the VMs were created, Read finds them with the client token, instead of leaving them
+	// behind. The VMs launched before an error are kept in the state to be terminated later.
+	d.SetId(resource.UniqueId())
+	vmIDs, err := createOAPIVMs(d, meta, nil)
+	if len(vmIDs) > 0 {
+		if err := d.Set("vm_ids", vmIDs); err != nil {
+			return err
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := waitForOAPIVMsState(conn, vmIDs, "running", d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	return resourceOAPIVMsRead(d, meta)
+}
+
+func resourceOAPIVMsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*OutscaleClient).OSCAPI
+
+	vmIDs, err := oapiVMsInStateOrLaunched(d, conn)
+	if err != nil {
+		return err
+	}
+	if len(vmIDs) == 0 {
+		log.Printf("[WARN] No VMs launched with the client token %s, removing them from state", d.Get("client_token").(string))
+		d.SetId("")
+		return nil
+	}
+	vms, requestID, err := readOAPIVMs(conn, vmIDs)
+	if err != nil {
+		return fmt.Errorf("error reading the VMs: %s", err)
+	}
+
+	// Keep the launch order of the VMs, the last ones are terminated first when scaling down.
+	byID := make(map[string]oscgo.Vm, len(vms))
+	for _, vm := range vms {
+		byID[vm.GetVmId()] = vm
+	}
+	var running []oscgo.Vm
+	for _, id := range vmIDs {
+		vm, ok := byID[id]
+		if !ok || vm.GetState() == "shutting-down" || vm.GetState() == "terminated" {
+			log.Printf("[WARN] VM (%s) not found or terminated, it will be launched again", id)
+			continue
+		}
+		running = append(running, vm)
+	}
+
+	if len(running) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	ids := make([]string, len(running))
+	vmsList := make([]map[string]interface{}, len(running))
+	for i, vm := range running {
+		ids[i] = vm.GetVmId()
+		vmsList[i] = map[string]interface{}{
+			"vm_id":                    vm.GetVmId(),
+			"state":                    vm.GetState(),
+			"placement_subregion_name": vm.Placement.GetSubregionName(),
+			"private_dns_name":         vm.GetPrivateDnsName(),
+			"private_ip":               vm.GetPrivateIp(),
+			"public_dns_name":          vm.GetPublicDnsName(),
+			"public_ip":                vm.GetPublicIp(),
+		}
+	}
+
+	if err := d.Set("vm_ids", ids); err != nil {
+		return err
+	}
+	if err := d.Set("vms", vmsList); err != nil {
+		return err
+	}
+	if err := d.Set("vm_type", running[0].GetVmType()); err != nil {
+		return err
+	}
+	if err := d.Set("request_id", requestID); err != nil {
+		return err
+	}
+	return setOSCAPITagsAttributes(d, meta.(*OutscaleClient), running[0].GetTags())
+}
+
+func resourceOAPIVMsUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*OutscaleClient).OSCAPI
+
+	vmIDs := oapiVMsInState(d)
+	maxCount := d.Get("max_count").(int)
+
+	if err := setOSCAPIResourcesTags(meta.(*OutscaleClient), d, vmIDs); err != nil {
+		return err
+	}
+
+	switch {
+	case len(vmIDs) < maxCount:
+		launched, err := createOAPIVMs(d, meta, vmIDs)
+		if len(launched) > 0 {
+			if err := d.Set("vm_ids", append(vmIDs, launched...)); err != nil {
+				return err
+			}
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := waitForOAPIVMsState(conn, launched, "running", d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	case len(vmIDs) > maxCount:
+		terminated := vmIDs[maxCount:]
+		if err := deleteOAPIVMs(conn, terminated, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+		if err := d.Set("vm_ids", vmIDs[:maxCount]); err != nil {
+			return err
+		}
+	}
+
+	return resourceOAPIVMsRead(d, meta)
+}
+
+// oapiVMsInState returns the VMs saved in the state. When max_count changes, vm_ids is planned
+// as computed and d.Get returns an empty list during the apply, so the old value is used.
+func oapiVMsInState(d *schema.ResourceData) []string {
+	o, _ := d.GetChange("vm_ids")
+	return expandStringValueList(o.([]interface{}))
+}
+
+func resourceOAPIVMsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*OutscaleClient).OSCAPI
+
+	vmIDs, err := oapiVMsInStateOrLaunched(d, conn)
+	if err != nil {
+		return err
+	}
+	return deleteOAPIVMs(conn, vmIDs, d.Timeout(schema.TimeoutDelete))
+}
+
+// oapiVMsInStateOrLaunched returns the VMs in the state or, when the launch of the VMs failed
+// before they were saved, the VMs launched with the client token of the resource.
+func oapiVMsInStateOrLaunched(d *schema.ResourceData, conn *oscgo.APIClient) ([]string, error) {
+	if vmIDs := expandStringValueList(d.Get("vm_ids").([]interface{})); len(vmIDs) > 0 {
+		return vmIDs, nil
+	}
+	vms, err := readOAPIVMsByClientToken(conn, d.Get("client_token").(string))
+	if err != nil {
+		return nil, err
+	}
+	vmIDs := make([]string, len(vms))
+	for i, vm := range vms {
+		vmIDs[i] = vm.GetVmId()
+	}
+	return vmIDs, nil
+}
+
+// createOAPIVMs launches the VMs missing from current to reach max_count, in one call, and
+// tags them. At least min_count VMs must be running once they are launched.
+func createOAPIVMs(d *schema.ResourceData, meta interface{}, current []string) ([]string, error) {
+	conn := meta.(*OutscaleClient).OSCAPI
+
+	missing := d.Get("max_count").(int) - len(current)
+	minCount := d.Get("min_count").(int) - len(current)
+	if minCount < 1 {
+		minCount = 1
+	}
+
+	request := buildCreateOAPIVMsRequest(d, int32(minCount), int32(missing))
+
+	// The token must change with the VMs already launched, or the API would return the VMs
+	// of the first launch again when scaling up, but stays the same for the same launch.
+	if token := d.Get("client_token").(string); token != "" {
+		if len(current) > 0 {
+			token = fmt.Sprintf("%s-%d", token, hashcode.String(strings.Join(current, ",")))
+		}
+		request.SetClientToken(token)
+	}
+
+	var resp oscgo.CreateVmsResponse
+	var err error
+	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		var httpResp *http.Response
+		resp, httpResp, err = conn.VmApi.CreateVms(context.Background()).CreateVmsRequest(request).Execute()
+		if err != nil {
+			if isOAPIRetryableCreateError(httpResp, err) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error launching the VMs: %s", utils.GetErrorResponse(err))
+	}
+
+	vmIDs := make([]string, len(resp.GetVms()))
+	for i, vm := range resp.GetVms() {
+		vmIDs[i] = vm.GetVmId()
+	}
+	if len(vmIDs) == 0 {
+		return nil, fmt.Errorf("error launching the VMs: no VMs returned in response")
+	}
+	if len(vmIDs) < missing {
+		log.Printf("[WARN] Only %d of the %d VMs requested were launched", len(vmIDs), missing)
+	}
+
+	if err := assignResourcesTags(d.Get("tags").(*schema.Set), vmIDs, meta.(*OutscaleClient)); err != nil {
+		return vmIDs, err
+	}
+	return vmIDs, nil
+}
+
+func buildCreateOAPIVMsRequest(d *schema.ResourceData, minCount, maxCount int32) oscgo.CreateVmsRequest {
+	request := oscgo.CreateVmsRequest{
+		BootOnCreation: oscgo.PtrBool(true),
+		BsuOptimized:   oscgo.PtrBool(d.Get("bsu_optimized").(bool)),
+		ImageId:        d.Get("image_id").(string),
+		MaxVmsCount:    oscgo.PtrInt32(maxCount),
+		MinVmsCount:    oscgo.PtrInt32(minCount),
+		Placement:      expandPlacement(d),
+	}
+
+	if sgIDs := expandStringValueList(d.Get("security_group_ids").([]interface{})); len(sgIDs) > 0 {
+		request.SetSecurityGroupIds(sgIDs)
+	}
+	if sgNames := expandStringValueList(d.Get("security_group_names").([]interface{})); len(sgNames) > 0 {
+		request.SetSecurityGroups(sgNames)
+	}
+	if v := d.Get("subnet_id").(string); v != "" {
+		request.SetSubnetId(v)
+	}
+	if v := expandOAPIVMUserData(d); v != "" {
+		request.SetUserData(v)
+	}
+	if v := d.Get("vm_type").(string); v != "" {
+		request.SetVmType(v)
+	}
+	if v := d.Get("keypair_name").(string); v != "" {
+		request.SetKeypairName(v)
+	}
+	if v := d.Get("vm_initiated_shutdown_behavior").(string); v != "" {
+		request.SetVmInitiatedShutdownBehavior(v)
+	}
+	if v := d.Get("performance").(string); v != "" {
+		request.SetPerformance(v)
+	}
+
+	return request
+}
+
+func readOAPIVMs(conn *oscgo.APIClient, vmIDs []string) ([]oscgo.Vm, string, error) {
+	req := oscgo.ReadVmsRequest{
+		Filters: &oscgo.FiltersVm{VmIds: &vmIDs},
+	}
+
+	var resp oscgo.ReadVmsResponse
+	var err error
+	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		resp, _, err = conn.VmApi.ReadVms(context.Background()).ReadVmsRequest(req).Execute()
+		if err != nil {
+			if strings.Contains(fmt.Sprint(err), "RequestLimitExceeded:") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, "", utils.GetErrorResponse(err)
+	}
+
+	return resp.GetVms(), resp.ResponseContext.GetRequestId(), nil
+}
+
+func deleteOAPIVMs(conn *oscgo.APIClient, vmIDs []string, timeout time.Duration) error {
+	if len(vmIDs) == 0 {
+		return nil
+	}
+
+	log.Printf("[INFO] Terminating VMs: %s", strings.Join(vmIDs, ", "))
+
+	var err error
+	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		_, _, err = conn.VmApi.DeleteVms(context.Background()).DeleteVmsRequest(oscgo.DeleteVmsRequest{
+			VmIds: vmIDs,
+		}).Execute()
+		if err != nil {
+			if strings.Contains(fmt.Sprint(err), "RequestLimitExceeded:") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error terminating the VMs %s: %s", strings.Join(vmIDs, ", "), utils.GetErrorResponse(err))
+	}
+
+	return waitForOAPIVMsState(conn, vmIDs, "terminated", timeout)
+}
+
+// waitForOAPIVMsState waits for all the VMs vmIDs to be in the state target. A VM missing
+// from the API is considered terminated.
+func waitForOAPIVMsState(conn *oscgo.APIClient, vmIDs []string, target string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"pending"},
+		Target:     []string{target},
+		Refresh:    vmsStateRefreshFunc(conn, vmIDs, target),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for the VMs %s to be %s: %s", strings.Join(vmIDs, ", "), target, err)
+	}
+	return nil
+}
+
+// vmsStateRefreshFunc returns target when all the VMs vmIDs are in this state, and pending
+// otherwise. A VM terminated while waiting for another state fails.
+func vmsStateRefreshFunc(conn *oscgo.APIClient, vmIDs []string, target string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		vms, _, err := readOAPIVMs(conn, vmIDs)
+		if err != nil {
+			return nil, "", err
+		}
+
+		states := make(map[string]string, len(vms))
+		for _, vm := range vms {
+			states[vm.GetVmId()] = vm.GetState()
+		}
+
+		for _, id := range vmIDs {
+			state, ok := states[id]
+			if !ok {
+				state = "terminated"
+			}
+			if state == target {
+				continue
+			}
+			if state == "terminated" {
+				return vms, state, fmt.Errorf("VM (%s) terminated%s", id, vmConsoleOutputTail(conn, id))
+			}
+			return vms, "pending", nil
+		}
+		return vms, target, nil
+	}
+}
@@ -0,0 +1,131 @@
+package outscale
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccOutscaleOAPIVMs_scale(t *testing.T) {
+	omi := os.Getenv("OUTSCALE_IMAGEID")
+	keypair := os.Getenv("OUTSCALE_KEYPAIR")
+	resourceName := "outscale_vms.outscale_vms"
+
+	var firstVMID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOutscaleOAPIVMsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOutscaleOAPIVMsConfig(omi, keypair, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "vm_ids.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "vms.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "vms.0.state", "running"),
+					resource.TestCheckResourceAttrSet(resourceName, "vms.0.private_ip"),
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "1"),
+					func(s *terraform.State) error {
+						firstVMID = s.RootModule().Resources[resourceName].Primary.Attributes["vm_ids.0"]
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccOutscaleOAPIVMsConfig(omi, keypair, 3),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "vm_ids.#", "3"),
+					resource.TestCheckResourceAttrPtr(resourceName, "vm_ids.0", &firstVMID),
+				),
+			},
+			{
+				Config: testAccOutscaleOAPIVMsConfig(omi, keypair, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "vm_ids.#", "1"),
+					resource.TestCheckResourceAttrPtr(resourceName, "vm_ids.0", &firstVMID),
+				),
+			},
+		},
+	})
+}
+
+func TestOAPIVMsInState(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "vms",
+		Attributes: map[string]string{
+			"vm_ids.#":  "2",
+			"vm_ids.0":  "i-00000001",
+			"vm_ids.1":  "i-00000002",
+			"max_count": "2",
+		},
+	}
+	// Scaling planned by resourceOAPIVMsCustomizeDiff.
+	diff := &terraform.InstanceDiff{
+		Attributes: map[string]*terraform.ResourceAttrDiff{
+			"max_count": {Old: "2", New: "3"},
+			"vm_ids.#":  {Old: "2", NewComputed: true},
+		},
+	}
+
+	d, err := schema.InternalMap(resourceOutscaleOAPIVMs().Schema).Data(state, diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := oapiVMsInState(d); len(got) != 2 || got[0] != "i-00000001" || got[1] != "i-00000002" {
+		t.Fatalf("expected the VMs in state, got %v", got)
+	}
+}
+
+func testAccCheckOutscaleOAPIVMsDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*OutscaleClient).OSCAPI
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "outscale_vms" {
+			continue
+		}
+
+		count, _ := strconv.Atoi(rs.Primary.Attributes["vm_ids.#"])
+		if count == 0 {
+			continue
+		}
+		vmIDs := make([]string, count)
+		for i := range vmIDs {
+			vmIDs[i] = rs.Primary.Attributes[fmt.Sprintf("vm_ids.%d", i)]
+		}
+
+		vms, _, err := readOAPIVMs(conn, vmIDs)
+		if err != nil {
+			return err
+		}
+		for _, vm := range vms {
+			if vm.GetState() != "terminated" {
+				return fmt.Errorf("VM (%s) still exists", vm.GetVmId())
+			}
+		}
+	}
+	return nil
+}
+
+func testAccOutscaleOAPIVMsConfig(omi, keypair string, count int) string {
+	return fmt.Sprintf(`
+		resource "outscale_vms" "outscale_vms" {
+			image_id     = "%s"
+			vm_type      = "tinav4.c2r2p2"
+			keypair_name = "%s"
+			max_count    = %d
+			client_token = "testacc-vms"
+
+			tags {
+				key   = "name"
+				value = "Terraform-VMs"
+			}
+		}
+	`, omi, keypair, count)
+}
@@ -26,6 +26,11 @@ func resourceOutscaleOAPIVolume() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceOAPIVolumeCustomizeDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			// Arguments
 			"subregion_name": {
@@ -36,13 +41,11 @@ func resourceOutscaleOAPIVolume() *schema.Resource {
 			"iops": {
 				Type:     schema.TypeInt,
 				Optional: true,
-				ForceNew: true,
 				Computed: true,
 			},
 			"size": {
 				Type:     schema.TypeInt,
 				Optional: true,
-				ForceNew: true,
 				Computed: true,
 			},
 			"snapshot_id": {
@@ -54,7 +57,6 @@ func resourceOutscaleOAPIVolume() *schema.Resource {
 			"volume_type": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 				Computed: true,
 			},
 			// Attributes
@@ -90,7 +92,8 @@ func resourceOutscaleOAPIVolume() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsListOAPISchema(),
+			"tags":     tagsListOAPISchema(),
+			"tags_all": tagsOAPIListSchemaComputed(),
 			"volume_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -154,7 +157,7 @@ func resourceOAPIVolumeCreate(d *schema.ResourceData, meta interface{}) error {
 		Pending:    []string{"creating"},
 		Target:     []string{"available"},
 		Refresh:    volumeOAPIStateRefreshFunc(conn, resp.Volume.GetVolumeId()),
-		Timeout:    5 * time.Minute,
+		Timeout:    d.Timeout(schema.TimeoutCreate),
 		Delay:      10 * time.Second,
 		MinTimeout: 3 * time.Second,
 	}
@@ -167,7 +170,7 @@ func resourceOAPIVolumeCreate(d *schema.ResourceData, meta interface{}) error {
 	d.SetId(resp.Volume.GetVolumeId())
 
 	if d.IsNewResource() {
-		if err := setOSCAPITags(conn, d); err != nil {
+		if err := setOSCAPITags(meta.(*OutscaleClient), d); err != nil {
 			return err
 		}
 		d.SetPartial("tags")
@@ -205,7 +208,7 @@ func resourceOAPIVolumeRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error reading Outscale volume %s: %s", d.Id(), err)
 	}
 
-	return readOAPIVolume(d, resp.GetVolumes()[0])
+	return readOAPIVolume(d, meta, resp.GetVolumes()[0])
 }
 
 func resourceOAPIVolumeUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -213,28 +216,138 @@ func resourceOAPIVolumeUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	d.Partial(true)
 
-	if err := setOSCAPITags(conn, d); err != nil {
+	if err := setOSCAPITags(meta.(*OutscaleClient), d); err != nil {
 		return err
 	}
 
 	d.SetPartial("tags")
 
+	if d.HasChanges("size", "iops", "volume_type") {
+		if err := updateOAPIVolume(d, conn); err != nil {
+			return err
+		}
+		d.SetPartial("size")
+		d.SetPartial("iops")
+		d.SetPartial("volume_type")
+	}
+
+	d.Partial(false)
+	return resourceOAPIVolumeRead(d, meta)
+}
+
+func updateOAPIVolume(d *schema.ResourceData, conn *oscgo.APIClient) (err error) {
+	request := oscgo.UpdateVolumeRequest{VolumeId: d.Id()}
+
+	if d.HasChange("size") {
+		request.SetSize(int32(d.Get("size").(int)))
+	}
+
+	vType := d.Get("volume_type").(string)
+	if d.HasChange("volume_type") {
+		request.SetVolumeType(vType)
+	}
+	if vType == "io1" && (d.HasChange("iops") || d.HasChange("volume_type")) {
+		request.SetIops(int32(d.Get("iops").(int)))
+	}
+
+	// Size and type can only be changed on a cold volume, so a volume linked to a
+	// running VM needs the VM to be stopped for the duration of the update.
+	if request.HasSize() || request.HasVolumeType() {
+		var vmID string
+		if vmID, err = getOAPIVolumeRunningVMID(conn, d.Id()); err != nil {
+			return err
+		}
+		if vmID != "" {
+			log.Printf("[DEBUG] Stopping VM (%s) to update Volume (%s)", vmID, d.Id())
+			if err := stopVM(vmID, conn); err != nil {
+				return err
+			}
+			// The VM is started again even if the update fails. The deferred function
+			// reports its own failure through the named result err.
+			defer func() {
+				log.Printf("[DEBUG] Starting VM (%s) after Volume (%s) update", vmID, d.Id())
+				if serr := startVM(vmID, conn); serr != nil {
+					if err != nil {
+						err = fmt.Errorf("%s\nError starting VM (%s): %s", err, vmID, serr)
+					} else {
+						err = serr
+					}
+				}
+			}()
+		}
+	}
+
+	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		_, _, err := conn.VolumeApi.UpdateVolume(context.Background()).UpdateVolumeRequest(request).Execute()
+		if err != nil {
+			if strings.Contains(err.Error(), "RequestLimitExceeded:") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating Outscale BSU volume (%s): %s", d.Id(), utils.GetErrorResponse(err))
+	}
+
 	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"creating"},
-		Target:     []string{"available"},
+		Pending:    []string{"creating", "updating"},
+		Target:     []string{"available", "in-use"},
 		Refresh:    volumeOAPIStateRefreshFunc(conn, d.Id()),
-		Timeout:    5 * time.Minute,
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
 		Delay:      10 * time.Second,
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err := stateConf.WaitForState()
-	if err != nil {
+	if _, err := stateConf.WaitForState(); err != nil {
 		return fmt.Errorf("Error waiting for Volume (%s) to update: %s", d.Id(), err)
 	}
 
-	d.Partial(false)
-	return resourceOAPIVolumeRead(d, meta)
+	return nil
+}
+
+// getOAPIVolumeRunningVMID returns the ID of the running VM the volume is linked to, if any.
+func getOAPIVolumeRunningVMID(conn *oscgo.APIClient, volumeID string) (string, error) {
+	resp, _, err := conn.VolumeApi.ReadVolumes(context.Background()).ReadVolumesRequest(oscgo.ReadVolumesRequest{
+		Filters: &oscgo.FiltersVolume{VolumeIds: &[]string{volumeID}},
+	}).Execute()
+	if err != nil {
+		return "", fmt.Errorf("Error reading Outscale volume %s: %s", volumeID, utils.GetErrorResponse(err))
+	}
+	if len(resp.GetVolumes()) == 0 {
+		return "", fmt.Errorf("Unable to find Outscale volume %s", volumeID)
+	}
+
+	for _, link := range resp.GetVolumes()[0].GetLinkedVolumes() {
+		if link.GetVmId() == "" {
+			continue
+		}
+		vmResp, _, err := readVM(link.GetVmId(), conn)
+		if err != nil {
+			return "", fmt.Errorf("Error reading VM (%s) linked to volume %s: %s", link.GetVmId(), volumeID, utils.GetErrorResponse(err))
+		}
+		if len(vmResp.GetVms()) > 0 && vmResp.GetVms()[0].GetState() == "running" {
+			return link.GetVmId(), nil
+		}
+	}
+	return "", nil
+}
+
+func resourceOAPIVolumeCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if err := customizeDiffOAPITagsAll(diff, meta); err != nil {
+		return err
+	}
+
+	if diff.Id() == "" || !diff.HasChange("size") {
+		return nil
+	}
+
+	o, n := diff.GetChange("size")
+	if oldSize, newSize := o.(int), n.(int); newSize > 0 && newSize < oldSize {
+		return fmt.Errorf("size of volume %s cannot be reduced from %d to %d GiB", diff.Id(), oldSize, newSize)
+	}
+	return nil
 }
 
 func resourceOAPIVolumeDelete(d *schema.ResourceData, meta interface{}) error {
@@ -282,7 +395,7 @@ func volumeOAPIStateRefreshFunc(conn *oscgo.APIClient, volumeID string) resource
 	}
 }
 
-func readOAPIVolume(d *schema.ResourceData, volume oscgo.Volume) error {
+func readOAPIVolume(d *schema.ResourceData, meta interface{}, volume oscgo.Volume) error {
 	d.SetId(volume.GetVolumeId())
 
 	if err := d.Set("subregion_name", volume.GetSubregionName()); err != nil {
@@ -359,7 +472,7 @@ func readOAPIVolume(d *schema.ResourceData, volume oscgo.Volume) error {
 		}
 	}
 	if volume.GetTags() != nil {
-		if err := d.Set("tags", tagsOSCAPIToMap(volume.GetTags())); err != nil {
+		if err := setOSCAPITagsAttributes(d, meta.(*OutscaleClient), volume.GetTags()); err != nil {
 			return err
 		}
 	} else {
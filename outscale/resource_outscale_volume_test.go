@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"regexp"
 	"strings"
 	"testing"
 	"time"
@@ -68,6 +69,79 @@ func TestAccOutscaleOAPIVolume_updateSize(t *testing.T) {
 	})
 }
 
+func TestAccOutscaleOAPIVolume_updateInPlace(t *testing.T) {
+	region := os.Getenv("OUTSCALE_REGION")
+
+	var before, after oscgo.Volume
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: "outscale_volume.test",
+		Providers:     testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOutscaleOAPIVolumeConfig(region),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOAPIVolumeExists("outscale_volume.test", &before),
+				),
+			},
+			{
+				Config: testOutscaleOAPIVolumeConfigUpdateType(region),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOAPIVolumeExists("outscale_volume.test", &after),
+					testAccCheckOAPIVolumeNotRecreated(&before, &after),
+					resource.TestCheckResourceAttr("outscale_volume.test", "size", "10"),
+					resource.TestCheckResourceAttr("outscale_volume.test", "volume_type", "io1"),
+					resource.TestCheckResourceAttr("outscale_volume.test", "iops", "100"),
+				),
+			},
+			{
+				Config:      testAccOutscaleOAPIVolumeConfig(region),
+				ExpectError: regexp.MustCompile("cannot be reduced"),
+			},
+		},
+	})
+}
+
+func TestAccOutscaleOAPIVolume_updateSizeLinked(t *testing.T) {
+	omi := os.Getenv("OUTSCALE_IMAGEID")
+	region := os.Getenv("OUTSCALE_REGION")
+	keypair := os.Getenv("OUTSCALE_KEYPAIR")
+
+	var before, after oscgo.Volume
+	var vm oscgo.Vm
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testOutscaleOAPIVolumeConfigLinked(omi, region, keypair, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOAPIVolumeExists("outscale_volume.test", &before),
+				),
+			},
+			{
+				Config: testOutscaleOAPIVolumeConfigLinked(omi, region, keypair, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOAPIVolumeExists("outscale_volume.test", &after),
+					testAccCheckOAPIVolumeNotRecreated(&before, &after),
+					resource.TestCheckResourceAttr("outscale_volume.test", "size", "2"),
+					testAccCheckOutscaleOAPIVMExists("outscale_vm.test", &vm),
+					func(*terraform.State) error {
+						if vm.GetState() != "running" {
+							return fmt.Errorf("expected VM %s to be running after the volume update, got %s", vm.GetVmId(), vm.GetState())
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func TestAccOutscaleOAPIVolume_io1Type(t *testing.T) {
 	region := os.Getenv("OUTSCALE_REGION")
 
@@ -130,6 +204,15 @@ func testAccCheckOAPIVolumeExists(n string, v *oscgo.Volume) resource.TestCheckF
 	}
 }
 
+func testAccCheckOAPIVolumeNotRecreated(before, after *oscgo.Volume) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before.GetVolumeId() != after.GetVolumeId() {
+			return fmt.Errorf("Volume was recreated: %s != %s", before.GetVolumeId(), after.GetVolumeId())
+		}
+		return nil
+	}
+}
+
 func testAccOutscaleOAPIVolumeConfig(region string) string {
 	return fmt.Sprintf(`
 		resource "outscale_volume" "test" {
@@ -160,6 +243,45 @@ func testOutscaleOAPIVolumeConfigUpdateSize(region string) string {
 	`, region)
 }
 
+func testOutscaleOAPIVolumeConfigUpdateType(region string) string {
+	return fmt.Sprintf(`
+		resource "outscale_volume" "test" {
+			subregion_name = "%sa"
+			volume_type    = "io1"
+			size           = 10
+			iops           = 100
+
+			tags {
+				key   = "Name"
+				value = "tf-acc-test-ebs-volume-test"
+			}
+		}
+	`, region)
+}
+
+func testOutscaleOAPIVolumeConfigLinked(omi, region, keypair string, size int) string {
+	return fmt.Sprintf(`
+		resource "outscale_vm" "test" {
+			image_id                 = "%[1]s"
+			vm_type                  = "tinav4.c2r2p2"
+			keypair_name             = "%[3]s"
+			placement_subregion_name = "%[2]sa"
+		}
+
+		resource "outscale_volume" "test" {
+			subregion_name = "%[2]sa"
+			volume_type    = "gp2"
+			size           = %[4]d
+		}
+
+		resource "outscale_volumes_link" "test" {
+			device_name = "/dev/sdh"
+			volume_id   = "${outscale_volume.test.id}"
+			vm_id       = "${outscale_vm.test.id}"
+		}
+	`, omi, region, keypair, size)
+}
+
 func testOutscaleOAPIVolumeConfigIO1Type(region string) string {
 	return fmt.Sprintf(`
 		resource "outscale_volume" "test-io" {
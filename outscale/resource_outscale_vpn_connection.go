@@ -22,6 +22,7 @@ func resourceOutscaleVPNConnection() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffOAPITagsAll,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -82,7 +83,8 @@ func resourceOutscaleVPNConnection() *schema.Resource {
 					},
 				},
 			},
-			"tags": tagsListOAPISchema(),
+			"tags":     tagsListOAPISchema(),
+			"tags_all": tagsOAPIListSchemaComputed(),
 			"request_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -109,11 +111,8 @@ func resourceOutscaleVPNConnectionCreate(d *schema.ResourceData, meta interface{
 		return fmt.Errorf("Error creating Outscale VPN Conecction: %s", err)
 	}
 
-	if tags, ok := d.GetOk("tags"); ok {
-		err := assignTags(tags.(*schema.Set), *vpn.GetVpnConnection().VpnConnectionId, conn)
-		if err != nil {
-			return err
-		}
+	if err := assignTags(d.Get("tags").(*schema.Set), *vpn.GetVpnConnection().VpnConnectionId, meta.(*OutscaleClient)); err != nil {
+		return err
 	}
 
 	d.SetId(*vpn.GetVpnConnection().VpnConnectionId)
@@ -167,7 +166,7 @@ func resourceOutscaleVPNConnectionRead(d *schema.ResourceData, meta interface{})
 	if err := d.Set("routes", flattenVPNConnection(vpnConnection.GetRoutes())); err != nil {
 		return err
 	}
-	if err := d.Set("tags", tagsOSCAPIToMap(vpnConnection.GetTags())); err != nil {
+	if err := setOSCAPITagsAttributes(d, meta.(*OutscaleClient), vpnConnection.GetTags()); err != nil {
 		return err
 	}
 
@@ -175,11 +174,11 @@ func resourceOutscaleVPNConnectionRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceOutscaleVPNConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*OutscaleClient).OSCAPI
+	client := meta.(*OutscaleClient)
 
 	d.Partial(true)
 
-	if err := setOSCAPITags(conn, d); err != nil {
+	if err := setOSCAPITags(client, d); err != nil {
 		return err
 	}
 
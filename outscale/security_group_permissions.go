@@ -0,0 +1,208 @@
+package outscale
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	oscgo "github.com/outscale/osc-sdk-go/v2"
+	"github.com/terraform-providers/terraform-provider-outscale/utils"
+)
+
+// securityGroupPermission is a single permission of a security group rule. The API merges the
+// permissions with the same protocol and ports in one rule holding several IP ranges, members
+// or services, so the rules are compared permission by permission.
+type securityGroupPermission struct {
+	ipProtocol        string
+	fromPortRange     int32
+	toPortRange       int32
+	ipRange           string
+	accountID         string
+	securityGroupID   string
+	securityGroupName string
+	serviceID         string
+}
+
+// expandSecurityGroupPermissions splits the rules into their permissions.
+func expandSecurityGroupPermissions(rules []oscgo.SecurityGroupRule) []securityGroupPermission {
+	var permissions []securityGroupPermission
+	for _, rule := range rules {
+		base := securityGroupPermission{
+			ipProtocol:    rule.GetIpProtocol(),
+			fromPortRange: rule.GetFromPortRange(),
+			toPortRange:   rule.GetToPortRange(),
+		}
+		if base.ipProtocol == "" {
+			base.ipProtocol = "-1"
+		}
+
+		for _, ipRange := range rule.GetIpRanges() {
+			permission := base
+			permission.ipRange = ipRange
+			permissions = append(permissions, permission)
+		}
+		for _, member := range rule.GetSecurityGroupsMembers() {
+			permission := base
+			permission.accountID = member.GetAccountId()
+			permission.securityGroupID = member.GetSecurityGroupId()
+			permission.securityGroupName = member.GetSecurityGroupName()
+			permissions = append(permissions, permission)
+		}
+		for _, serviceID := range rule.GetServiceIds() {
+			permission := base
+			permission.serviceID = serviceID
+			permissions = append(permissions, permission)
+		}
+	}
+	return permissions
+}
+
+// key returns the fields identifying the permission. The ports don't matter for all the
+// protocols, written -1 or all, and a member is identified by its ID when it is known, the API fills the account
+// ID and the name of the members itself.
+func (p securityGroupPermission) key() securityGroupPermission {
+	key := p
+	key.ipProtocol = strings.ToLower(key.ipProtocol)
+	if key.ipProtocol == "all" {
+		key.ipProtocol = "-1"
+	}
+	if key.ipProtocol == "-1" {
+		key.fromPortRange, key.toPortRange = 0, 0
+	}
+	if key.securityGroupID != "" {
+		key.accountID, key.securityGroupName = "", ""
+	}
+	return key
+}
+
+// rule returns the rule to send to the API to create or delete the permission.
+func (p securityGroupPermission) rule() oscgo.SecurityGroupRule {
+	rule := oscgo.SecurityGroupRule{}
+	rule.SetIpProtocol(p.ipProtocol)
+	if p.ipProtocol != "-1" {
+		rule.SetFromPortRange(p.fromPortRange)
+		rule.SetToPortRange(p.toPortRange)
+	}
+
+	switch {
+	case p.ipRange != "":
+		rule.SetIpRanges([]string{p.ipRange})
+	case p.serviceID != "":
+		rule.SetServiceIds([]string{p.serviceID})
+	default:
+		member := oscgo.SecurityGroupsMember{}
+		if p.accountID != "" {
+			member.SetAccountId(p.accountID)
+		}
+		if p.securityGroupID != "" {
+			member.SetSecurityGroupId(p.securityGroupID)
+		}
+		if p.securityGroupName != "" {
+			member.SetSecurityGroupName(p.securityGroupName)
+		}
+		rule.SetSecurityGroupsMembers([]oscgo.SecurityGroupsMember{member})
+	}
+	return rule
+}
+
+// diffSecurityGroupPermissions returns the permissions of wanted missing from current, and
+// the permissions of current missing from wanted.
+func diffSecurityGroupPermissions(current, wanted []securityGroupPermission) (added, removed []securityGroupPermission) {
+	currentKeys := make(map[securityGroupPermission]bool, len(current))
+	for _, permission := range current {
+		currentKeys[permission.key()] = true
+	}
+	wantedKeys := make(map[securityGroupPermission]bool, len(wanted))
+	for _, permission := range wanted {
+		key := permission.key()
+		if !wantedKeys[key] && !currentKeys[key] {
+			added = append(added, permission)
+		}
+		wantedKeys[key] = true
+	}
+	for _, permission := range current {
+		key := permission.key()
+		if !wantedKeys[key] {
+			removed = append(removed, permission)
+			wantedKeys[key] = true
+		}
+	}
+	return added, removed
+}
+
+func hasSecurityGroupPermission(permissions []securityGroupPermission, permission securityGroupPermission) bool {
+	for _, p := range permissions {
+		if p.key() == permission.key() {
+			return true
+		}
+	}
+	return false
+}
+
+// sameSecurityGroupPermissions tells if the rules a and b grant the same permissions, however
+// they are grouped.
+func sameSecurityGroupPermissions(a, b []oscgo.SecurityGroupRule) bool {
+	added, removed := diffSecurityGroupPermissions(expandSecurityGroupPermissions(a), expandSecurityGroupPermissions(b))
+	return len(added) == 0 && len(removed) == 0
+}
+
+// updateSecurityGroupPermissions adds then removes permissions of the flow of the security
+// group securityGroupID, so the permissions kept in both are never interrupted.
+func updateSecurityGroupPermissions(conn *oscgo.APIClient, securityGroupID, flow string, added, removed []securityGroupPermission) error {
+	if len(added) > 0 {
+		req := oscgo.CreateSecurityGroupRuleRequest{
+			Flow:            flow,
+			SecurityGroupId: securityGroupID,
+			Rules:           securityGroupPermissionRules(added),
+		}
+
+		var err error
+		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+			_, _, err = conn.SecurityGroupRuleApi.CreateSecurityGroupRule(context.Background()).CreateSecurityGroupRuleRequest(req).Execute()
+			if err != nil {
+				if strings.Contains(err.Error(), "RequestLimitExceeded") {
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("error adding %s rules to the security group %s: %s", strings.ToLower(flow), securityGroupID, utils.GetErrorResponse(err))
+		}
+	}
+
+	if len(removed) > 0 {
+		req := oscgo.DeleteSecurityGroupRuleRequest{
+			Flow:            flow,
+			SecurityGroupId: securityGroupID,
+			Rules:           securityGroupPermissionRules(removed),
+		}
+
+		var err error
+		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+			_, _, err = conn.SecurityGroupRuleApi.DeleteSecurityGroupRule(context.Background()).DeleteSecurityGroupRuleRequest(req).Execute()
+			if err != nil {
+				if strings.Contains(err.Error(), "RequestLimitExceeded") {
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("error removing %s rules from the security group %s: %s", strings.ToLower(flow), securityGroupID, utils.GetErrorResponse(err))
+		}
+	}
+	return nil
+}
+
+func securityGroupPermissionRules(permissions []securityGroupPermission) *[]oscgo.SecurityGroupRule {
+	rules := make([]oscgo.SecurityGroupRule, len(permissions))
+	for i, permission := range permissions {
+		rules[i] = permission.rule()
+	}
+	return &rules
+}
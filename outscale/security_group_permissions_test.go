@@ -0,0 +1,83 @@
+package outscale
+
+import (
+	"testing"
+
+	oscgo "github.com/outscale/osc-sdk-go/v2"
+)
+
+func testSecurityGroupRule(protocol string, from, to int32, ipRanges ...string) oscgo.SecurityGroupRule {
+	rule := oscgo.SecurityGroupRule{}
+	rule.SetIpProtocol(protocol)
+	rule.SetFromPortRange(from)
+	rule.SetToPortRange(to)
+	rule.SetIpRanges(ipRanges)
+	return rule
+}
+
+func TestExpandSecurityGroupPermissions(t *testing.T) {
+	rule := testSecurityGroupRule("tcp", 22, 22, "10.0.0.0/16", "192.168.0.0/24")
+	rule.SetSecurityGroupsMembers([]oscgo.SecurityGroupsMember{{SecurityGroupId: oscgo.PtrString("sg-12345678")}})
+	rule.SetServiceIds([]string{"pl-12345678"})
+
+	permissions := expandSecurityGroupPermissions([]oscgo.SecurityGroupRule{rule})
+	if len(permissions) != 4 {
+		t.Fatalf("expected 4 permissions, got %d: %v", len(permissions), permissions)
+	}
+	if permissions[1].ipRange != "192.168.0.0/24" || permissions[1].fromPortRange != 22 {
+		t.Fatalf("unexpected permission: %#v", permissions[1])
+	}
+	if permissions[2].securityGroupID != "sg-12345678" || permissions[3].serviceID != "pl-12345678" {
+		t.Fatalf("unexpected permissions: %#v", permissions[2:])
+	}
+}
+
+func TestDiffSecurityGroupPermissions(t *testing.T) {
+	current := expandSecurityGroupPermissions([]oscgo.SecurityGroupRule{
+		testSecurityGroupRule("tcp", 22, 22, "10.0.0.0/16", "192.168.0.0/24"),
+		testSecurityGroupRule("-1", -1, -1, "0.0.0.0/0"),
+	})
+	wanted := expandSecurityGroupPermissions([]oscgo.SecurityGroupRule{
+		testSecurityGroupRule("tcp", 22, 22, "10.0.0.0/16"),
+		testSecurityGroupRule("tcp", 22, 22, "172.16.0.0/12"),
+		testSecurityGroupRule("-1", 0, 0, "0.0.0.0/0"),
+	})
+
+	added, removed := diffSecurityGroupPermissions(current, wanted)
+	if len(added) != 1 || added[0].ipRange != "172.16.0.0/12" {
+		t.Fatalf("expected to add 172.16.0.0/12, got %v", added)
+	}
+	if len(removed) != 1 || removed[0].ipRange != "192.168.0.0/24" {
+		t.Fatalf("expected to remove 192.168.0.0/24, got %v", removed)
+	}
+}
+
+func TestSameSecurityGroupPermissions(t *testing.T) {
+	grouped := []oscgo.SecurityGroupRule{testSecurityGroupRule("tcp", 80, 80, "10.0.0.0/16", "192.168.0.0/24")}
+	split := []oscgo.SecurityGroupRule{
+		testSecurityGroupRule("tcp", 80, 80, "192.168.0.0/24"),
+		testSecurityGroupRule("tcp", 80, 80, "10.0.0.0/16"),
+	}
+	if !sameSecurityGroupPermissions(grouped, split) {
+		t.Fatal("expected the rules to grant the same permissions")
+	}
+
+	other := []oscgo.SecurityGroupRule{testSecurityGroupRule("tcp", 443, 443, "10.0.0.0/16", "192.168.0.0/24")}
+	if sameSecurityGroupPermissions(grouped, other) {
+		t.Fatal("expected the rules to grant different permissions")
+	}
+
+	member := oscgo.SecurityGroupRule{}
+	member.SetIpProtocol("tcp")
+	member.SetSecurityGroupsMembers([]oscgo.SecurityGroupsMember{{SecurityGroupId: oscgo.PtrString("sg-12345678")}})
+	memberFromAPI := oscgo.SecurityGroupRule{}
+	memberFromAPI.SetIpProtocol("tcp")
+	memberFromAPI.SetSecurityGroupsMembers([]oscgo.SecurityGroupsMember{{
+		AccountId:         oscgo.PtrString("123456789012"),
+		SecurityGroupId:   oscgo.PtrString("sg-12345678"),
+		SecurityGroupName: oscgo.PtrString("web"),
+	}})
+	if !sameSecurityGroupPermissions([]oscgo.SecurityGroupRule{member}, []oscgo.SecurityGroupRule{memberFromAPI}) {
+		t.Fatal("expected the members to be identified by their ID")
+	}
+}
@@ -1,8 +1,13 @@
 package outscale
 
 import (
+	"bytes"
 	"io/ioutil"
+	"log"
+	"math"
+	"math/rand"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -10,10 +15,21 @@ import (
 	v4 "github.com/aws/aws-sdk-go/aws/signer/v4"
 )
 
+const (
+	defaultMaxRetries      = 10
+	defaultRetryMaxBackoff = 30 * time.Second
+	retryMinBackoff        = 1 * time.Second
+)
+
+// throttlingCodes are the error codes returned by the API when the request rate is exceeded.
+var throttlingCodes = []string{"RequestLimitExceeded", "Throttling", "TooManyRequests"}
+
 type transport struct {
-	transport http.RoundTripper
-	signer    *v4.Signer
-	region    string
+	transport  http.RoundTripper
+	signer     *v4.Signer
+	region     string
+	maxRetries int
+	maxBackoff time.Duration
 }
 
 func (t *transport) sign(req *http.Request, body []byte) error {
@@ -24,27 +40,104 @@ func (t *transport) sign(req *http.Request, body []byte) error {
 }
 
 func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
-	body, err := ioutil.ReadAll(req.Body)
-	if err != nil {
-		return nil, err
+	var body []byte
+	if req.Body != nil {
+		var err error
+		if body, err = ioutil.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+	}
+
+	for attempt := 0; ; attempt++ {
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		// The signature is time based, so it has to be computed again on each attempt.
+		if err := t.sign(req, body); err != nil {
+			return nil, err
+		}
+
+		resp, err := t.transport.RoundTrip(req)
+		if err != nil || attempt >= t.maxRetries || !isRetryableResponse(resp) {
+			return resp, err
+		}
+
+		wait := t.backoff(attempt, resp)
+		log.Printf("[DEBUG] Retrying request %s %s after %s (status %d, attempt %d/%d)",
+			req.Method, req.URL.Path, wait, resp.StatusCode, attempt+1, t.maxRetries)
+		resp.Body.Close()
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(wait):
+		}
 	}
+}
 
-	if err := t.sign(req, body); err != nil {
-		return nil, err
+// backoff returns the time to wait before the next attempt, either from the Retry-After
+// header of the response or an exponential backoff with jitter, capped to maxBackoff.
+func (t *transport) backoff(attempt int, resp *http.Response) time.Duration {
+	if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+		if wait > t.maxBackoff {
+			return t.maxBackoff
+		}
+		return wait
 	}
 
-	resp, err := t.transport.RoundTrip(req)
-	if err != nil {
-		return resp, err
+	backoff := float64(retryMinBackoff) * math.Pow(2, float64(attempt))
+	if backoff > float64(t.maxBackoff) {
+		backoff = float64(t.maxBackoff)
 	}
+	// Jitter keeps concurrent requests from retrying in lockstep.
+	return time.Duration(backoff/2 + rand.Float64()*backoff/2)
+}
 
-	return resp, nil
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		if wait := time.Until(date); wait > 0 {
+			return wait, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// isRetryableResponse tells if a request can be sent again. Most requests are not idempotent,
+// so only the errors returned before the request is processed are retried: throttling, and
+// the gateway errors. Other server errors may come after the request succeeded.
+func isRetryableResponse(resp *http.Response) bool {
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		// Some throttling errors come back as other errors, only the body tells them apart.
+		body, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+		if err != nil {
+			return false
+		}
+		for _, code := range throttlingCodes {
+			if bytes.Contains(body, []byte(code)) {
+				return true
+			}
+		}
+	}
+	return false
 }
 
-func NewTransport(accessKey, accessSecret, region string, t http.RoundTripper) *transport {
+func NewTransport(accessKey, accessSecret, region string, maxRetries int, maxBackoff time.Duration, t http.RoundTripper) *transport {
 	s := &v4.Signer{
 		Credentials: credentials.NewStaticCredentials(accessKey,
 			accessSecret, ""),
 	}
-	return &transport{t, s, region}
+	return &transport{t, s, region, maxRetries, maxBackoff}
 }
@@ -0,0 +1,134 @@
+package outscale
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTransport_RetryThrottling(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"VmIds":["i-12345678"]}` {
+			t.Errorf("attempt %d: unexpected body %q", attempts, body)
+		}
+		if r.Header.Get("Authorization") == "" {
+			t.Errorf("attempt %d: request is not signed", attempts)
+		}
+
+		switch attempts {
+		case 1:
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case 2:
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"Errors":[{"Code":"RequestLimitExceeded"}]}`))
+		default:
+			w.Write([]byte(`{}`))
+		}
+	}))
+	defer server.Close()
+
+	client := &http.Client{
+		Transport: NewTransport("ak", "sk", "eu-west-2", 3, 10*time.Millisecond, http.DefaultTransport),
+	}
+
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{"VmIds":["i-12345678"]}`))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", resp.StatusCode)
+	}
+	if attempts != 3 {
+		t.Fatalf("expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestTransport_NoRetryOnClientError(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"Errors":[{"Code":"InvalidParameterValue"}]}`))
+	}))
+	defer server.Close()
+
+	client := &http.Client{
+		Transport: NewTransport("ak", "sk", "eu-west-2", 3, 10*time.Millisecond, http.DefaultTransport),
+	}
+
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{}`))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer resp.Body.Close()
+
+	body, _ := ioutil.ReadAll(resp.Body)
+	if !strings.Contains(string(body), "InvalidParameterValue") {
+		t.Fatalf("expected the error body to be preserved, got %q", body)
+	}
+	if attempts != 1 {
+		t.Fatalf("expected 1 attempt, got %d", attempts)
+	}
+}
+
+func TestTransport_RetryExhausted(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := &http.Client{
+		Transport: NewTransport("ak", "sk", "eu-west-2", 2, 10*time.Millisecond, http.DefaultTransport),
+	}
+
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{}`))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("expected status 429, got %d", resp.StatusCode)
+	}
+	if attempts != 3 {
+		t.Fatalf("expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestTransport_NoRetryOnInternalServerError(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(`{"Errors":[{"Code":"InternalError"}]}`))
+	}))
+	defer server.Close()
+
+	client := &http.Client{
+		Transport: NewTransport("ak", "sk", "eu-west-2", 3, 10*time.Millisecond, http.DefaultTransport),
+	}
+
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{}`))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusInternalServerError {
+		t.Fatalf("expected status 500, got %d", resp.StatusCode)
+	}
+	if attempts != 1 {
+		t.Fatalf("expected 1 attempt, got %d", attempts)
+	}
+}